@@ -6,4 +6,23 @@ require (
     github.com/charmbracelet/bubbletea v0.25.0
     github.com/charmbracelet/lipgloss v0.9.1
     github.com/charmbracelet/bubbles v0.18.0
-)
\ No newline at end of file
+)
+
+require (
+    github.com/atotto/clipboard v0.1.4 // indirect
+    github.com/aymanbagabas/go-osc52/v2 v2.0.1 // indirect
+    github.com/charmbracelet/harmonica v0.2.0 // indirect
+    github.com/containerd/console v1.0.4-0.20230313162750-1ae8d489ac81 // indirect
+    github.com/lucasb-eyer/go-colorful v1.2.0 // indirect
+    github.com/mattn/go-isatty v0.0.18 // indirect
+    github.com/mattn/go-localereader v0.0.1 // indirect
+    github.com/mattn/go-runewidth v0.0.15 // indirect
+    github.com/muesli/ansi v0.0.0-20211018074035-2e021307bc4b // indirect
+    github.com/muesli/cancelreader v0.2.2 // indirect
+    github.com/muesli/reflow v0.3.0 // indirect
+    github.com/muesli/termenv v0.15.2 // indirect
+    github.com/rivo/uniseg v0.4.6 // indirect
+    golang.org/x/sync v0.1.0 // indirect
+    golang.org/x/sys v0.12.0 // indirect
+    golang.org/x/term v0.6.0 // indirect
+)
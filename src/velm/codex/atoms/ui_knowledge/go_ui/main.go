@@ -2,8 +2,8 @@ package main
 
 import (
     "fmt"
+    "log/slog"
     "os"
-    "strings"
 
     tea "github.com/charmbracelet/bubbletea"
     "github.com/charmbracelet/lipgloss"
@@ -12,34 +12,69 @@ import (
     "gnostic-tui/ui/atoms"
     "gnostic-tui/ui/molecules"
     "gnostic-tui/ui/organisms"
+    "gnostic-tui/ui/state"
+    "gnostic-tui/ui/toast"
+    "gnostic-tui/ui/zone"
     "github.com/charmbracelet/bubbles/spinner"
-    "github.com/charmbracelet/bubbles/table"
 )
 
 type model struct {
     // State
-    tabs        []string
-    activeTab   int
+    tabs        organisms.Tabs
     quitting    bool
     width       int
     height      int
 
     // Components
     spinner     spinner.Model
-    dataTable   table.Model
+    deploy      atoms.Button
+    reset       atoms.Button
+    dataTable   organisms.DataTable
+    logs        organisms.LogBuffer
+    toaster     molecules.Toaster
     help        tea.Model // Using generic model interface for simplicity here
 }
 
+// Demo samples for the overview sparklines
+var (
+    cpuHistory  = []float64{38, 42, 51, 47, 55, 61, 58, 49, 46, 52, 48, 45}
+    heapHistory = []float64{0.9, 0.95, 1.0, 1.0, 1.05, 1.1, 1.08, 1.12, 1.15, 1.18, 1.2, 1.2}
+)
+
 func initialModel() model {
     s := atoms.NewGnosticSpinner()
     t := organisms.NewDataTable()
+    t.FitWindow(4) // Content padding
 
-    return model{
-        tabs:      []string{"Overview", "Data", "System"},
-        activeTab: 0,
+    logs := organisms.NewLogBuffer(60, 8)
+    logs.SetShowMeta(true)
+    logs.SetMinimap(true)
+    logs.AppendLevel(organisms.LevelDebug, "Loaded theme "+theme.Active())
+    store, err := state.DefaultFileStore("gnostic-tui")
+    if err == nil {
+        err = t.PersistLayout(store, "scriptures.columns")
+    }
+    if err != nil {
+        logs.AppendLevel(organisms.LevelWarn, "Column layout will not be kept: "+err.Error())
+    }
+    logs.Append("System initialized.\nListening for Gnostic signals...")
+
+    m := model{
+        tabs: organisms.NewTabs(
+            organisms.Tab{Title: "Overview"},
+            organisms.Tab{Title: "Data"},
+            organisms.Tab{Title: "System"},
+            organisms.Tab{Title: "Theme", Closable: true},
+        ),
         spinner:   s,
+        deploy:    atoms.NewButton("Deploy").WithVariant(atoms.ButtonPrimary),
+        reset:     atoms.NewButton("Reset").WithVariant(atoms.ButtonDanger),
         dataTable: t,
+        logs:      logs,
+        toaster:   molecules.NewToaster(),
     }
+    m.focusTab()
+    return m
 }
 
 func (m model) Init() tea.Cmd {
@@ -52,93 +87,185 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
     switch msg := msg.(type) {
     case tea.KeyMsg:
-        switch msg.String() {
+        if (m.dataTable.Capturing() || m.logs.Capturing()) && msg.String() != "ctrl+c" {
+            break // Keys belong to a filter, search or prompt
+        }
+        key := msg.String()
+        if m.logs.Focused() && (key == "left" || key == "right") {
+            break // The log scrolls sideways with them
+        }
+        switch key {
         case "q", "ctrl+c":
             m.quitting = true
             return m, tea.Quit
-        case "tab", "right":
-            m.activeTab = (m.activeTab + 1) % len(m.tabs)
-        case "shift+tab", "left":
-            if m.activeTab > 0 {
-                m.activeTab--
-            } else {
-                m.activeTab = len(m.tabs) - 1
-            }
+        case "right":
+            m.tabs.Step(1)
+        case "left":
+            m.tabs.Step(-1)
+        default:
+            m.tabs, cmd = m.tabs.Update(msg)
+            cmds = append(cmds, cmd)
+        }
+        m.focusTab()
+    case tea.MouseMsg:
+        m.tabs, cmd = m.tabs.Update(msg)
+        cmds = append(cmds, cmd)
+        m.focusTab()
+    case organisms.TabClosedMsg:
+        m.logs.Append("Closed the " + msg.ID + " tab")
+    case atoms.ButtonPressedMsg:
+        switch msg.ID {
+        case m.deploy.ID():
+            cmds = append(cmds, m.deploy.SetLoading(true), toast.Show("Deployment started"))
+            m.logs.Append("Deployment started")
+        case m.reset.ID():
+            m.deploy.SetLoading(false)
+            cmds = append(cmds, toast.Warn("Deployment cancelled"))
+            m.logs.AppendLevel(organisms.LevelWarn, "Deployment cancelled")
+        }
+    case organisms.TableExportedMsg:
+        if msg.Err != nil {
+            cmds = append(cmds, toast.Error("Export failed: "+msg.Err.Error()))
+        } else if msg.Path == "" {
+            cmds = append(cmds, toast.Success(fmt.Sprintf("Copied %d rows", msg.Rows)))
+        } else {
+            cmds = append(cmds, toast.Success(fmt.Sprintf("Exported %d rows to %s", msg.Rows, msg.Path)))
+        }
+    case organisms.TableLayoutErrMsg:
+        cmds = append(cmds, toast.Warn("Could not save column layout: "+msg.Err.Error()))
+    case organisms.LogExportedMsg:
+        if msg.Err != nil {
+            cmds = append(cmds, toast.Error("Save failed: "+msg.Err.Error()))
+        } else {
+            cmds = append(cmds, toast.Success(fmt.Sprintf("Saved %d lines to %s", msg.Lines, msg.Path)))
+        }
+    case organisms.LogCopiedMsg:
+        if msg.Err != nil {
+            cmds = append(cmds, toast.Error("Copy failed: "+msg.Err.Error()))
+        } else {
+            cmds = append(cmds, toast.Success(fmt.Sprintf("Copied %d lines", msg.Lines)))
         }
     case tea.WindowSizeMsg:
         m.width = msg.Width
         m.height = msg.Height
+        m.tabs.SetWidth(msg.Width - 4)
     }
 
     // Update sub-components
     m.spinner, cmd = m.spinner.Update(msg)
     cmds = append(cmds, cmd)
 
+    m.deploy, cmd = m.deploy.Update(msg)
+    cmds = append(cmds, cmd)
+
+    m.reset, cmd = m.reset.Update(msg)
+    cmds = append(cmds, cmd)
+
     m.dataTable, cmd = m.dataTable.Update(msg)
     cmds = append(cmds, cmd)
 
+    m.logs, cmd = m.logs.Update(msg)
+    cmds = append(cmds, cmd)
+
+    m.toaster, cmd = m.toaster.Update(msg)
+    cmds = append(cmds, cmd)
+
     return m, tea.Batch(cmds...)
 }
 
+// focusTab hands the keyboard to the component on the active tab
+func (m *model) focusTab() {
+    m.dataTable.Blur()
+    m.logs.Blur()
+    switch m.tabs.ActiveID() {
+    case "Data":
+        m.dataTable.Focus()
+    case "System":
+        m.logs.Focus()
+    }
+}
+
 func (m model) View() string {
     if m.quitting {
         return "The Gnostic UI returns to the void.\\n"
     }
 
     // 1. Header / Tabs
-    tabBar := organisms.RenderTabs(m.tabs, m.activeTab, m.width-4)
+    tabBar := m.tabs.View()
 
     var content string
 
     // 2. Content Area
-    switch m.activeTab {
-    case 0: // Overview
-        welcome := theme.TitleStyle.Render("Welcome to the Citadel")
+    switch m.tabs.ActiveID() {
+    case "Overview":
+        welcome := lipgloss.JoinVertical(lipgloss.Left,
+            atoms.BigText("Citadel"),
+            "",
+            theme.TitleStyle.Render("Welcome to the Citadel"),
+        )
 
         // Row 1: Metrics
         metrics := lipgloss.JoinHorizontal(lipgloss.Top,
-            molecules.Card("CPU Usage", molecules.StatusRow("Core 1", "45%", "Normal", atoms.BadgeSuccess), 30),
+            molecules.StatCard("CPU Usage", "45%", -3.2, cpuHistory, molecules.StatCardInverted()),
             lipgloss.NewStyle().Width(2).Render(""), // Gap
-            molecules.Card("Memory", molecules.StatusRow("Heap", "1.2GB", "High", atoms.BadgeWarning), 30),
+            molecules.StatCard("Memory", atoms.FormatBytes(1288490189), 4.1, heapHistory, molecules.StatCardInverted()),
         )
 
         // Row 2: Spinner & Buttons
-        controls := lipgloss.JoinHorizontal(lipgloss.Center, 
-            lipgloss.NewStyle().MarginRight(2).Render(m.spinner.View() + " Processing..."),
-            atoms.NewButton("Deploy").View(),
-            atoms.NewButton("Reset").View(),
+        controls := lipgloss.JoinHorizontal(lipgloss.Center,
+            lipgloss.NewStyle().MarginRight(2).Render(m.spinner.View()+" Processing..."),
+            m.deploy.View(),
+            m.reset.View(),
         )
 
         content = lipgloss.JoinVertical(lipgloss.Left, welcome, metrics, "\\n", controls)
 
-    case 1: // Data
+    case "Data":
         content = lipgloss.JoinVertical(lipgloss.Left,
             theme.TitleStyle.Render("Scripture Registry"),
             m.dataTable.View(),
         )
 
-    case 2: // System
+    case "System":
         content = lipgloss.JoinVertical(lipgloss.Left,
             theme.TitleStyle.Render("System Status"),
             molecules.RenderProgress(molecules.NewProgressBar(40), "Initialization"),
             "\\n",
-            molecules.Card("Alert", "System integrity at 99%. Gnostic field stable.", 50),
+            molecules.Alert("Alert", "System integrity at 99%. Gnostic field stable.", atoms.BadgeSuccess, molecules.AlertWidth(50)),
+            "",
+            m.logs.View(),
+        )
+
+    case "Theme":
+        content = lipgloss.JoinVertical(lipgloss.Left,
+            theme.TitleStyle.Render("Theme: "+theme.Active()),
+            organisms.ThemePreview(),
         )
     }
 
     // 3. Layout
-    return lipgloss.JoinVertical(lipgloss.Left,
+    frame := zone.Scan(lipgloss.JoinVertical(lipgloss.Left,
         tabBar,
         "\\n",
         lipgloss.NewStyle().Padding(1, 2).Render(content),
         "\\n",
-        lipgloss.NewStyle().Foreground(theme.Subtext).Render("Press 'q' to quit • 'tab' to switch view"),
-    )
+        atoms.Kbd("q")+theme.Caption.Render(" quit • ")+atoms.Kbd("tab")+theme.Caption.Render(" switch view"),
+    ))
+
+    // 4. Floating layers go over the finished frame
+    return m.toaster.Overlay(m.dataTable.Overlay(frame))
 }
 
 func main() {
-    p := tea.NewProgram(initialModel(), tea.WithAltScreen())
-    if _, err := p.Run(); err != nil {
+    m := initialModel()
+    p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion())
+    // Stray log output would draw over the alt screen; send it to the System
+    // tab. This covers the log package too.
+    logs := organisms.NewLogHandler(m.logs, p, &slog.HandlerOptions{Level: slog.LevelDebug})
+    slog.SetDefault(slog.New(logs))
+    _, err := p.Run()
+    logs.Close()
+    if err != nil {
         fmt.Printf("Alas, there's been an error: %v", err)
         os.Exit(1)
     }
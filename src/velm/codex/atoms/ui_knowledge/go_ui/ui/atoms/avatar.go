@@ -0,0 +1,60 @@
+package atoms
+
+import (
+    "hash/fnv"
+    "strings"
+    "unicode"
+
+    "github.com/charmbracelet/lipgloss"
+)
+
+// avatarColors are the backgrounds an avatar can land on; all take white text
+var avatarColors = []lipgloss.Color{
+    "#6366f1", // Indigo
+    "#ec4899", // Pink
+    "#059669", // Emerald
+    "#d97706", // Amber
+    "#dc2626", // Red
+    "#0284c7", // Sky
+    "#7c3aed", // Violet
+    "#0d9488", // Teal
+    "#ea580c", // Orange
+    "#4d7c0f", // Lime
+}
+
+// Avatar renders the initials of name on a background picked from a hash of
+// the name, so the same person always gets the same color.
+func Avatar(name string) string {
+    h := fnv.New32a()
+    h.Write([]byte(strings.ToLower(strings.TrimSpace(name))))
+    color := avatarColors[h.Sum32()%uint32(len(avatarColors))]
+
+    return lipgloss.NewStyle().
+        Background(color).
+        Foreground(lipgloss.Color("#ffffff")).
+        Bold(true).
+        Padding(0, 1).
+        Render(initials(name))
+}
+
+// initials takes the first letters of the first and last word, or the first
+// two letters of a single word
+func initials(name string) string {
+    words := strings.FieldsFunc(name, func(r rune) bool {
+        return unicode.IsSpace(r) || r == '-' || r == '_' || r == '.' || r == '@'
+    })
+
+    var rs []rune
+    switch len(words) {
+    case 0:
+        return "?"
+    case 1:
+        rs = []rune(words[0])
+        if len(rs) > 2 {
+            rs = rs[:2]
+        }
+    default:
+        rs = []rune{[]rune(words[0])[0], []rune(words[len(words)-1])[0]}
+    }
+    return strings.ToUpper(string(rs))
+}
@@ -1,6 +1,8 @@
 package atoms
 
 import (
+    "strconv"
+
     "github.com/charmbracelet/lipgloss"
     "gnostic-tui/ui/theme"
 )
@@ -14,21 +16,114 @@ const (
     BadgeDanger
 )
 
-func Badge(text string, variant BadgeVariant) string {
-    base := lipgloss.NewStyle().
+// BadgeOption customises a Badge
+type BadgeOption func(*badgeConfig)
+
+type badgeConfig struct {
+    icon    bool
+    rounded bool
+}
+
+// BadgeIcon prefixes the text with the variant's icon: ✓ success, ! warning, ✗ danger
+func BadgeIcon() BadgeOption {
+    return func(c *badgeConfig) { c.icon = true }
+}
+
+// BadgeRounded closes the pill with half-block caps instead of square ends
+func BadgeRounded() BadgeOption {
+    return func(c *badgeConfig) { c.rounded = true }
+}
+
+// Badge renders text as a filled pill colored by its variant
+func Badge(text string, variant BadgeVariant, opts ...BadgeOption) string {
+    var cfg badgeConfig
+    for _, opt := range opts {
+        opt(&cfg)
+    }
+
+    if icon := variantIcon(variant); cfg.icon && icon != "" {
+        text = icon + " " + text
+    }
+
+    pill := lipgloss.NewStyle().
         Padding(0, 1).
-        HasForeground(true).
         Bold(true).
-        CornerRadius(3) // Rounded pills
+        Background(variantColor(variant)).
+        Foreground(variantText(variant)).
+        Render(text)
+    if !cfg.rounded {
+        return pill
+    }
+
+    edge := lipgloss.NewStyle().Foreground(variantColor(variant))
+    return edge.Render("▐") + pill + edge.Render("▌")
+}
+
+// variantIcon is the glyph BadgeIcon shows for a variant; info badges have none
+func variantIcon(variant BadgeVariant) string {
+    switch variant {
+    case BadgeSuccess:
+        return Icon("check")
+    case BadgeWarning:
+        return "!"
+    case BadgeDanger:
+        return Icon("cross")
+    default:
+        return ""
+    }
+}
 
+// variantColor is the theme color that represents a variant
+func variantColor(variant BadgeVariant) lipgloss.Color {
     switch variant {
     case BadgeSuccess:
-        return base.Background(theme.Accent).Foreground(lipgloss.Color("#000")).Render(text)
+        return theme.Accent
     case BadgeWarning:
-        return base.Background(theme.Warning).Foreground(lipgloss.Color("#000")).Render(text)
+        return theme.Warning
     case BadgeDanger:
-        return base.Background(theme.Danger).Foreground(lipgloss.Color("#fff")).Render(text)
+        return theme.Danger
+    default:
+        return theme.Primary
+    }
+}
+
+// variantText is the readable foreground on a variant-colored background
+func variantText(variant BadgeVariant) lipgloss.Color {
+    switch variant {
+    case BadgeSuccess, BadgeWarning:
+        return lipgloss.Color("#000")
     default:
-        return base.Background(theme.Primary).Foreground(lipgloss.Color("#fff")).Render(text)
+        return lipgloss.Color("#fff")
     }
-}
\ No newline at end of file
+}
+
+// Badge99Plus is the largest count BadgeCount spells out; larger counts render as "99+"
+const Badge99Plus = 99
+
+// BadgeCount renders an unread-style counter pill; zero and negative counts render nothing
+func BadgeCount(n int, variant BadgeVariant) string {
+    if n <= 0 {
+        return ""
+    }
+    text := strconv.Itoa(n)
+    if n > Badge99Plus {
+        text = strconv.Itoa(Badge99Plus) + "+"
+    }
+    return lipgloss.NewStyle().
+        Padding(0, 1).
+        Bold(true).
+        Background(variantColor(variant)).
+        Foreground(variantText(variant)).
+        Render(text)
+}
+
+// BadgeDot renders a single status dot, small enough to decorate a tab title
+func BadgeDot(variant BadgeVariant) string {
+    return lipgloss.NewStyle().Foreground(variantColor(variant)).Render("●")
+}
+
+// BadgeOutline renders the badge as colored text between thin walls, without a fill
+func BadgeOutline(text string, variant BadgeVariant) string {
+    style := lipgloss.NewStyle().Foreground(variantColor(variant))
+    return style.Render("▕") + style.Copy().Bold(true).Render(" "+text+" ") + style.Render("▏")
+}
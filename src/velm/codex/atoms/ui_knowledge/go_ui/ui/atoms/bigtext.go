@@ -0,0 +1,97 @@
+package atoms
+
+import (
+    "strings"
+    "unicode/utf8"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// blockFont is a three row typeface drawn with half and full blocks
+var blockFont = map[rune][3]string{
+    'A': {"█▀█", "█▀█", "▀ ▀"},
+    'B': {"█▀▄", "█▀▄", "▀▀ "},
+    'C': {"█▀▀", "█  ", "▀▀▀"},
+    'D': {"█▀▄", "█ █", "▀▀ "},
+    'E': {"█▀▀", "█▀▀", "▀▀▀"},
+    'F': {"█▀▀", "█▀▀", "▀  "},
+    'G': {"█▀▀", "█ █", "▀▀▀"},
+    'H': {"█ █", "█▀█", "▀ ▀"},
+    'I': {"▀█▀", " █ ", "▀▀▀"},
+    'J': {"  █", "█ █", "▀▀▀"},
+    'K': {"█ █", "█▀▄", "▀ ▀"},
+    'L': {"█  ", "█  ", "▀▀▀"},
+    'M': {"█▄ ▄█", "█ ▀ █", "▀   ▀"},
+    'N': {"█▄ █", "█ ▀█", "▀  ▀"},
+    'O': {"█▀█", "█ █", "▀▀▀"},
+    'P': {"█▀█", "█▀▀", "▀  "},
+    'Q': {"█▀█", "█ █", "▀▀█"},
+    'R': {"█▀█", "█▀▄", "▀ ▀"},
+    'S': {"█▀▀", "▀▀█", "▀▀▀"},
+    'T': {"▀█▀", " █ ", " ▀ "},
+    'U': {"█ █", "█ █", "▀▀▀"},
+    'V': {"█ █", "█ █", " ▀ "},
+    'W': {"█   █", "█ █ █", " ▀ ▀ "},
+    'X': {"█ █", "▄▀▄", "▀ ▀"},
+    'Y': {"█ █", " █ ", " ▀ "},
+    'Z': {"▀▀█", "▄▀ ", "▀▀▀"},
+    '0': {"█▀█", "█ █", "▀▀▀"},
+    '1': {"▄█ ", " █ ", "▀▀▀"},
+    '2': {"▀▀█", "█▀▀", "▀▀▀"},
+    '3': {"▀▀█", " ▀█", "▀▀▀"},
+    '4': {"█ █", "▀▀█", "  ▀"},
+    '5': {"█▀▀", "▀▀█", "▀▀▀"},
+    '6': {"█▀▀", "█▀█", "▀▀▀"},
+    '7': {"▀▀█", "  █", "  ▀"},
+    '8': {"█▀█", "█▀█", "▀▀▀"},
+    '9': {"█▀█", "▀▀█", "▀▀▀"},
+    ' ': {"  ", "  ", "  "},
+    '!': {"█", "▀", "▀"},
+    '?': {"▀▀█", " ▀ ", " ▀ "},
+    '.': {" ", " ", "▀"},
+    ',': {" ", " ", "▄"},
+    '-': {"  ", "▀▀", "  "},
+    ':': {"▄", "▄", " "},
+    '%': {"▀ █", " █ ", "█ ▄"},
+}
+
+// BigText renders s in large block letters shaded with the theme's
+// Primary to Secondary gradient. Characters without a glyph are skipped.
+func BigText(s string) string {
+    var rows [3]strings.Builder
+    first := true
+    for _, r := range strings.ToUpper(s) {
+        glyph, ok := blockFont[r]
+        if !ok {
+            continue
+        }
+        for i := range rows {
+            if !first {
+                rows[i].WriteString(" ")
+            }
+            rows[i].WriteString(glyph[i])
+        }
+        first = false
+    }
+
+    width := utf8.RuneCountInString(rows[0].String())
+    lines := make([]string, len(rows))
+    for i := range rows {
+        var line strings.Builder
+        for col, r := range []rune(rows[i].String()) {
+            if r == ' ' {
+                line.WriteRune(r)
+                continue
+            }
+            t := 0.0
+            if width > 1 {
+                t = float64(col) / float64(width-1)
+            }
+            color := theme.Blend(theme.Primary, theme.Secondary, t)
+            line.WriteString(lipgloss.NewStyle().Foreground(color).Render(string(r)))
+        }
+        lines[i] = line.String()
+    }
+    return strings.Join(lines, "\n")
+}
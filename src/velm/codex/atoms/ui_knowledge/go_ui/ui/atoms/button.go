@@ -1,34 +1,175 @@
 package atoms
 
 import (
+    "strconv"
+
+    "github.com/charmbracelet/bubbles/spinner"
+    tea "github.com/charmbracelet/bubbletea"
     "github.com/charmbracelet/lipgloss"
     "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
+)
+
+// ButtonPressedMsg is emitted when a button is activated
+type ButtonPressedMsg struct {
+    ID    int
+    Label string
+}
+
+// ButtonVariant sets the emphasis of a button. The zero value is the plain
+// secondary look NewButton has always had.
+type ButtonVariant int
+
+const (
+    ButtonSecondary ButtonVariant = iota
+    ButtonPrimary
+    ButtonGhost
+    ButtonDanger
 )
 
 // Button represents a clickable action
 type Button struct {
     Label    string
-    Active   bool
+    Variant  ButtonVariant
+    Active   bool // Keyboard focus, toggled by Focus and Blur
+    Disabled bool
+    Loading  bool // Shows a spinner in place of the label and blocks Press
     OnPress  func()
+    id       int
+    spinner  spinner.Model
 }
 
 func NewButton(label string) Button {
-    return Button{Label: label}
+    s := NewGnosticSpinner()
+    s.Style = lipgloss.NewStyle() // Take the colors of the button face
+    return Button{Label: label, id: nextID(), spinner: s}
+}
+
+// WithVariant returns a copy of the button using variant, for inline construction
+func (b Button) WithVariant(variant ButtonVariant) Button {
+    b.Variant = variant
+    return b
+}
+
+// ID identifies the button in the ButtonPressedMsg it emits
+func (b Button) ID() int {
+    return b.id
+}
+
+// ZoneID is the mouse zone the button registers in its View
+func (b Button) ZoneID() string {
+    return "button:" + strconv.Itoa(b.id)
+}
+
+func (b *Button) Focus() {
+    b.Active = true
+}
+
+func (b *Button) Blur() {
+    b.Active = false
+}
+
+func (b Button) Focused() bool {
+    return b.Active
+}
+
+// SetLoading toggles the loading state; the returned command starts the spinner
+func (b *Button) SetLoading(loading bool) tea.Cmd {
+    b.Loading = loading
+    if !loading {
+        return nil
+    }
+    return b.spinner.Tick
+}
+
+// Press activates the button: OnPress runs and a ButtonPressedMsg is emitted.
+// Disabled and loading buttons ignore it.
+func (b Button) Press() tea.Cmd {
+    if b.Disabled || b.Loading {
+        return nil
+    }
+    if b.OnPress != nil {
+        b.OnPress()
+    }
+    msg := ButtonPressedMsg{ID: b.id, Label: b.Label}
+    return func() tea.Msg { return msg }
+}
+
+func (b Button) Update(msg tea.Msg) (Button, tea.Cmd) {
+    if tick, ok := msg.(spinner.TickMsg); ok {
+        if !b.Loading {
+            return b, nil
+        }
+        var cmd tea.Cmd
+        b.spinner, cmd = b.spinner.Update(tick)
+        return b, cmd
+    }
+
+    if mouse, ok := msg.(tea.MouseMsg); ok {
+        if zone.Get(b.ZoneID()).Clicked(mouse) {
+            return b, b.Press()
+        }
+        return b, nil
+    }
+
+    if !b.Active {
+        return b, nil
+    }
+    if msg, ok := msg.(tea.KeyMsg); ok {
+        switch msg.String() {
+        case "enter", " ":
+            return b, b.Press()
+        }
+    }
+    return b, nil
 }
 
 func (b Button) View() string {
     style := lipgloss.NewStyle().
-        Padding(0, 3).
-        MarginRight(1).
-        Foreground(theme.Text).
-        Background(theme.Surface)
+        Padding(0, theme.Space(3)).
+        MarginRight(theme.Space(1))
+
+    label := b.Label
+    if b.Loading {
+        // Center the spinner over the label's footprint so the row doesn't shift
+        label = lipgloss.PlaceHorizontal(lipgloss.Width(b.Label), lipgloss.Center, b.spinner.View())
+    }
 
-    if b.Active {
-        style = style.
-            Background(theme.Primary).
-            Foreground(lipgloss.Color("#ffffff")).
-            Bold(true)
+    return zone.Mark(b.ZoneID(), b.variantStyle(style).Render(label))
+}
+
+// variantStyle colors the base style for the variant, focus and disabled state
+func (b Button) variantStyle(style lipgloss.Style) lipgloss.Style {
+    white := lipgloss.Color("#ffffff")
+
+    if b.Disabled {
+        if b.Variant == ButtonGhost {
+            return style.Foreground(theme.Subtext)
+        }
+        return style.Background(theme.Surface).Foreground(theme.Subtext)
     }
 
-    return style.Render(b.Label)
-}
\ No newline at end of file
+    switch b.Variant {
+    case ButtonPrimary:
+        style = style.Background(theme.Primary).Foreground(white)
+        if b.Active {
+            style = style.Bold(true).Underline(true)
+        }
+    case ButtonGhost:
+        style = style.Foreground(theme.Primary)
+        if b.Active {
+            style = style.Background(theme.Surface).Bold(true)
+        }
+    case ButtonDanger:
+        style = style.Background(theme.Surface).Foreground(theme.Danger)
+        if b.Active {
+            style = style.Background(theme.Danger).Foreground(white).Bold(true)
+        }
+    default:
+        style = style.Background(theme.Surface).Foreground(theme.Text)
+        if b.Active {
+            style = style.Background(theme.Primary).Foreground(white).Bold(true)
+        }
+    }
+    return style
+}
@@ -0,0 +1,86 @@
+package atoms
+
+import (
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// CheckState is the tri-state value of a Checkbox
+type CheckState int
+
+const (
+    Unchecked CheckState = iota
+    Checked
+    Indeterminate
+)
+
+// Checkbox is a focusable boolean option; space toggles it while focused
+type Checkbox struct {
+    Label   string
+    State   CheckState
+    focused bool
+}
+
+func NewCheckbox(label string) Checkbox {
+    return Checkbox{Label: label}
+}
+
+func (c *Checkbox) Focus() {
+    c.focused = true
+}
+
+func (c *Checkbox) Blur() {
+    c.focused = false
+}
+
+func (c Checkbox) Focused() bool {
+    return c.focused
+}
+
+func (c Checkbox) Checked() bool {
+    return c.State == Checked
+}
+
+// Toggle flips the box; an indeterminate box becomes checked
+func (c *Checkbox) Toggle() {
+    if c.State == Checked {
+        c.State = Unchecked
+    } else {
+        c.State = Checked
+    }
+}
+
+func (c Checkbox) Update(msg tea.Msg) (Checkbox, tea.Cmd) {
+    if !c.focused {
+        return c, nil
+    }
+    if msg, ok := msg.(tea.KeyMsg); ok && msg.String() == " " {
+        c.Toggle()
+    }
+    return c, nil
+}
+
+func (c Checkbox) View() string {
+    box := lipgloss.NewStyle().Foreground(theme.Subtext)
+    label := theme.Derive(lipgloss.NewStyle())
+
+    var mark string
+    switch c.State {
+    case Checked:
+        mark = "[✓]"
+        box = box.Foreground(theme.Accent)
+    case Indeterminate:
+        mark = "[–]"
+        box = box.Foreground(theme.Warning)
+    default:
+        mark = "[ ]"
+    }
+
+    if c.focused {
+        box = box.Foreground(theme.Primary).Bold(true)
+        label = label.Foreground(theme.Primary).Bold(true)
+    }
+
+    return box.Render(mark) + " " + label.Render(c.Label)
+}
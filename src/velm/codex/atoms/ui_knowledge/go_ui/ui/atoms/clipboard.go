@@ -0,0 +1,67 @@
+package atoms
+
+import (
+    "encoding/base64"
+    "errors"
+    "io"
+    "os"
+
+    tea "github.com/charmbracelet/bubbletea"
+)
+
+// ClipboardErrMsg reports that CopyToClipboard could not write to the
+// terminal
+type ClipboardErrMsg struct {
+    Err error
+}
+
+// CopyToClipboard puts text on the system clipboard through the terminal's
+// OSC 52 support, which also works over SSH. Inside tmux the sequence is
+// passed through to the outer terminal. A failed write comes back as a
+// ClipboardErrMsg.
+func CopyToClipboard(text string) tea.Cmd {
+    return ClipboardExec(text, func(err error) tea.Msg {
+        if err != nil {
+            return ClipboardErrMsg{Err: err}
+        }
+        return nil
+    })
+}
+
+// ClipboardExec is CopyToClipboard reporting the write's outcome through fn.
+// The sequence goes out through tea.Exec, so it never lands inside a frame:
+// the program finishes drawing and hands over its output first, stepping out
+// of the alternate screen for that moment, and repaints once it is written.
+func ClipboardExec(text string, fn tea.ExecCallback) tea.Cmd {
+    return tea.Exec(&clipboardWrite{seq: clipboardSeq(text)}, fn)
+}
+
+// clipboardWrite is a tea.ExecCommand writing an OSC 52 sequence to the
+// program's output
+type clipboardWrite struct {
+    seq string
+    out io.Writer
+}
+
+func (c *clipboardWrite) Run() error {
+    if c.out == nil {
+        return errors.New("clipboard: no terminal to write to")
+    }
+    _, err := io.WriteString(c.out, c.seq)
+    return err
+}
+
+func (c *clipboardWrite) SetStdin(io.Reader)    {}
+func (c *clipboardWrite) SetStdout(w io.Writer) { c.out = w }
+func (c *clipboardWrite) SetStderr(io.Writer)   {}
+
+// clipboardSeq is the OSC 52 sequence setting the clipboard to text,
+// wrapped for tmux when inside it
+func clipboardSeq(text string) string {
+    seq := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\a"
+    if os.Getenv("TMUX") != "" {
+        seq = "\x1bPtmux;\x1b" + seq + "\x1b\\"
+    }
+    return seq
+}
+
@@ -0,0 +1,78 @@
+package atoms
+
+import (
+    "fmt"
+    "strings"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// The arc climbs through these glyphs from left to right
+var gaugeRamp = []rune("▁▂▃▄▅▆▇█")
+
+type gaugeConfig struct {
+    warning, critical float64
+}
+
+// GaugeOption customizes a Gauge
+type GaugeOption func(*gaugeConfig)
+
+// GaugeThresholds sets the fill ratios (0..1) at which the gauge turns Amber and Red
+func GaugeThresholds(warning, critical float64) GaugeOption {
+    return func(c *gaugeConfig) {
+        c.warning, c.critical = warning, critical
+    }
+}
+
+// Gauge renders value out of total as a rising arc with the percentage centered below it
+func Gauge(value, total float64, width int, opts ...GaugeOption) string {
+    cfg := gaugeConfig{warning: 0.75, critical: 0.9}
+    for _, opt := range opts {
+        opt(&cfg)
+    }
+    if width < 1 {
+        width = 1
+    }
+
+    ratio := 0.0
+    if total > 0 {
+        ratio = value / total
+    }
+    if ratio < 0 {
+        ratio = 0
+    }
+    if ratio > 1 {
+        ratio = 1
+    }
+
+    color := theme.Accent
+    switch {
+    case ratio >= cfg.critical:
+        color = theme.Danger
+    case ratio >= cfg.warning:
+        color = theme.Warning
+    }
+
+    filled := int(ratio*float64(width) + 0.5)
+    var on, off strings.Builder
+    for i := 0; i < width; i++ {
+        glyph := gaugeRamp[i*len(gaugeRamp)/width]
+        if i < filled {
+            on.WriteRune(glyph)
+        } else {
+            off.WriteRune(glyph)
+        }
+    }
+
+    arc := lipgloss.NewStyle().Foreground(color).Render(on.String()) +
+        lipgloss.NewStyle().Foreground(theme.Border).Render(off.String())
+    label := lipgloss.NewStyle().
+        Width(width).
+        Align(lipgloss.Center).
+        Foreground(color).
+        Bold(true).
+        Render(fmt.Sprintf("%.0f%%", ratio*100))
+
+    return lipgloss.JoinVertical(lipgloss.Left, arc, label)
+}
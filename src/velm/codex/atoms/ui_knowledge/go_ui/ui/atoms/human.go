@@ -0,0 +1,112 @@
+package atoms
+
+import (
+    "fmt"
+    "strconv"
+    "strings"
+    "time"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+var byteUnits = []string{"B", "KB", "MB", "GB", "TB", "PB", "EB"}
+
+// FormatBytes spells a byte count with a binary-scaled unit, e.g. "12KB" or "1.2GB".
+// Values under ten keep one decimal.
+func FormatBytes(n int64) string {
+    neg := n < 0
+    if neg {
+        n = -n
+    }
+
+    v := float64(n)
+    unit := 0
+    for v >= 1024 && unit < len(byteUnits)-1 {
+        v /= 1024
+        unit++
+    }
+
+    s := fmt.Sprintf("%.0f", v)
+    if unit > 0 && v < 10 {
+        s = strings.TrimSuffix(fmt.Sprintf("%.1f", v), ".0")
+    }
+    if neg {
+        s = "-" + s
+    }
+    return s + byteUnits[unit]
+}
+
+// ParseBytes reads a size written by FormatBytes, or by hand as "45 KB" or
+// "3mb", back into a byte count
+func ParseBytes(text string) (int64, error) {
+    s := strings.ToUpper(strings.TrimSpace(text))
+    num := strings.TrimRightFunc(s, func(r rune) bool { return r >= 'A' && r <= 'Z' })
+    unit := strings.TrimSuffix(strings.TrimSuffix(s[len(num):], "IB"), "B") + "B"
+
+    v, err := strconv.ParseFloat(strings.TrimSpace(num), 64)
+    if err != nil {
+        return 0, fmt.Errorf("invalid size %q", text)
+    }
+    for i, u := range byteUnits {
+        if u == unit {
+            for ; i > 0; i-- {
+                v *= 1024
+            }
+            return int64(v), nil
+        }
+    }
+    return 0, fmt.Errorf("unknown size unit in %q", text)
+}
+
+// FormatDuration spells a duration compactly, e.g. "1h32m", "4m5s" or "350ms"
+func FormatDuration(d time.Duration) string {
+    if d < 0 {
+        return "-" + FormatDuration(-d)
+    }
+
+    switch {
+    case d < time.Second:
+        return fmt.Sprintf("%dms", d.Milliseconds())
+    case d < time.Minute:
+        return fmt.Sprintf("%ds", int(d.Seconds()))
+    case d < time.Hour:
+        return fmt.Sprintf("%dm%ds", int(d.Minutes()), int(d.Seconds())%60)
+    case d < 24*time.Hour:
+        return fmt.Sprintf("%dh%dm", int(d.Hours()), int(d.Minutes())%60)
+    default:
+        return fmt.Sprintf("%dd%dh", int(d.Hours())/24, int(d.Hours())%24)
+    }
+}
+
+// Bytes renders FormatBytes with the units dimmed against the number
+func Bytes(n int64) string {
+    return colorUnits(FormatBytes(n))
+}
+
+// Duration renders FormatDuration with the units dimmed against the numbers
+func Duration(d time.Duration) string {
+    return colorUnits(FormatDuration(d))
+}
+
+// colorUnits styles runs of digits as values and runs of letters as units
+func colorUnits(s string) string {
+    value := theme.Derive(lipgloss.NewStyle())
+    unit := lipgloss.NewStyle().Foreground(theme.Subtext)
+
+    var b strings.Builder
+    start := 0
+    isValue := func(r byte) bool { return (r >= '0' && r <= '9') || r == '.' || r == '-' }
+    for i := 1; i <= len(s); i++ {
+        if i < len(s) && isValue(s[i]) == isValue(s[start]) {
+            continue
+        }
+        if isValue(s[start]) {
+            b.WriteString(value.Render(s[start:i]))
+        } else {
+            b.WriteString(unit.Render(s[start:i]))
+        }
+        start = i
+    }
+    return b.String()
+}
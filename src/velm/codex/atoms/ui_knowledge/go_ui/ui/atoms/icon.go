@@ -0,0 +1,105 @@
+package atoms
+
+import (
+    "os"
+    "strings"
+    "sync"
+)
+
+// IconSet selects which glyph family Icon draws from
+type IconSet int
+
+const (
+    IconsUnicode IconSet = iota
+    IconsNerd
+    IconsASCII
+)
+
+// IconEnvVar overrides detection with "nerd", "unicode" or "ascii"
+const IconEnvVar = "GNOSTIC_ICONS"
+
+type glyphs struct {
+    nerd, unicode, ascii string
+}
+
+var (
+    iconMu  sync.RWMutex
+    iconSet = detectIconSet()
+    icons   = map[string]glyphs{
+        "check":      {"", "✓", "v"},
+        "cross":      {"", "✗", "x"},
+        "warning":    {"", "⚠", "!"},
+        "info":       {"", "ℹ", "i"},
+        "folder":     {"", "▸", "/"},
+        "file":       {"", "≡", "-"},
+        "git-branch": {"", "⎇", "br"},
+        "clock":      {"", "◷", "@"},
+        "search":     {"", "⌕", "?"},
+        "gear":       {"", "⚙", "*"},
+        "star":       {"", "★", "*"},
+        "star-half":  {"", "⯨", "+"},
+        "star-empty": {"", "☆", "."},
+    }
+)
+
+// detectIconSet guesses the glyph family from the environment. Nerd Fonts
+// cannot be probed, so only terminals that bundle the symbols opt in.
+func detectIconSet() IconSet {
+    switch strings.ToLower(os.Getenv(IconEnvVar)) {
+    case "nerd":
+        return IconsNerd
+    case "unicode":
+        return IconsUnicode
+    case "ascii":
+        return IconsASCII
+    }
+
+    if os.Getenv("TERM") == "linux" {
+        return IconsASCII // The kernel console has no glyphs beyond its codepage
+    }
+    switch os.Getenv("TERM_PROGRAM") {
+    case "WezTerm", "ghostty":
+        return IconsNerd
+    }
+    return IconsUnicode
+}
+
+// SetIconSet overrides the detected glyph family for every Icon call
+func SetIconSet(set IconSet) {
+    iconMu.Lock()
+    defer iconMu.Unlock()
+    iconSet = set
+}
+
+// CurrentIconSet returns the glyph family Icon draws from
+func CurrentIconSet() IconSet {
+    iconMu.RLock()
+    defer iconMu.RUnlock()
+    return iconSet
+}
+
+// RegisterIcon adds or replaces a semantic icon in all three glyph families
+func RegisterIcon(name, nerd, unicode, ascii string) {
+    iconMu.Lock()
+    defer iconMu.Unlock()
+    icons[name] = glyphs{nerd, unicode, ascii}
+}
+
+// Icon returns the glyph for a semantic name, or "" if the name is unknown
+func Icon(name string) string {
+    iconMu.RLock()
+    defer iconMu.RUnlock()
+
+    g, ok := icons[name]
+    if !ok {
+        return ""
+    }
+    switch iconSet {
+    case IconsNerd:
+        return g.nerd
+    case IconsASCII:
+        return g.ascii
+    default:
+        return g.unicode
+    }
+}
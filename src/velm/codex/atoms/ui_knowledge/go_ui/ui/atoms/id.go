@@ -0,0 +1,10 @@
+package atoms
+
+import "sync/atomic"
+
+var lastID int64
+
+// nextID hands out unique ids so animation ticks reach only the component that scheduled them
+func nextID() int {
+    return int(atomic.AddInt64(&lastID, 1))
+}
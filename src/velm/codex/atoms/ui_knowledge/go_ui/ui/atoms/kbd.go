@@ -0,0 +1,21 @@
+package atoms
+
+import (
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// KbdStyle is the face of a key cap without its walls. It survives Inline
+// rendering, so components like help.Model can use it for their key styles.
+func KbdStyle() lipgloss.Style {
+    return lipgloss.NewStyle().
+        Background(theme.Surface).
+        Foreground(theme.Text).
+        Bold(true)
+}
+
+// Kbd renders a key or chord such as "ctrl+c" as a bordered key cap
+func Kbd(keys string) string {
+    wall := lipgloss.NewStyle().Foreground(theme.Border)
+    return wall.Render("▕") + KbdStyle().Render(" "+keys+" ") + wall.Render("▏")
+}
@@ -0,0 +1,64 @@
+package atoms
+
+import (
+    "os"
+    "strconv"
+    "sync"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+var (
+    linkMu       sync.RWMutex
+    hyperlinksOn = detectHyperlinks()
+)
+
+// detectHyperlinks reports whether the terminal is known to understand OSC 8
+func detectHyperlinks() bool {
+    if os.Getenv("KITTY_WINDOW_ID") != "" || os.Getenv("WT_SESSION") != "" {
+        return true
+    }
+    switch os.Getenv("TERM_PROGRAM") {
+    case "iTerm.app", "WezTerm", "vscode", "ghostty", "Hyper":
+        return true
+    }
+    // VTE based terminals (GNOME Terminal, Tilix, ...) gained support in 0.50
+    if v, err := strconv.Atoi(os.Getenv("VTE_VERSION")); err == nil && v >= 5000 {
+        return true
+    }
+    return false
+}
+
+// SetHyperlinks forces OSC 8 output on or off, overriding detection
+func SetHyperlinks(enabled bool) {
+    linkMu.Lock()
+    defer linkMu.Unlock()
+    hyperlinksOn = enabled
+}
+
+// HyperlinksEnabled reports whether Link emits OSC 8 sequences
+func HyperlinksEnabled() bool {
+    linkMu.RLock()
+    defer linkMu.RUnlock()
+    return hyperlinksOn
+}
+
+// Link renders text as a clickable hyperlink to url, or as "text (url)" on
+// terminals without OSC 8 support. lipgloss counts the escape payload as
+// visible width, so keep links out of fixed-width padded containers.
+func Link(text string, url string) string {
+    style := lipgloss.NewStyle().Foreground(theme.Primary).Underline(true)
+
+    if !HyperlinksEnabled() {
+        if text == "" || text == url {
+            return style.Render(url)
+        }
+        return style.Render(text) + " " + lipgloss.NewStyle().Foreground(theme.Subtext).Render("("+url+")")
+    }
+
+    if text == "" {
+        text = url
+    }
+    return "\x1b]8;;" + url + "\x1b\\" + style.Render(text) + "\x1b]8;;\x1b\\"
+}
@@ -0,0 +1,25 @@
+package atoms
+
+import (
+    "os"
+    "sync/atomic"
+)
+
+// ReducedMotionEnvVar disables animations when set to any non-empty value
+const ReducedMotionEnvVar = "GNOSTIC_REDUCED_MOTION"
+
+var reducedMotion atomic.Bool
+
+func init() {
+    reducedMotion.Store(os.Getenv(ReducedMotionEnvVar) != "")
+}
+
+// SetReducedMotion turns animations off (pulses hold still, switches snap, skeletons stop shimmering)
+func SetReducedMotion(on bool) {
+    reducedMotion.Store(on)
+}
+
+// ReducedMotion reports whether animations are disabled
+func ReducedMotion() bool {
+    return reducedMotion.Load()
+}
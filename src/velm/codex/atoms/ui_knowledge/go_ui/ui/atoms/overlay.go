@@ -0,0 +1,87 @@
+package atoms
+
+import (
+    "strings"
+
+    "github.com/charmbracelet/lipgloss"
+)
+
+const sgrReset = "\x1b[0m"
+
+// Overlay draws fg on top of bg with its top-left corner at column x, row y.
+// Both may be styled: the covered part of bg is cut out cell-accurately and
+// its colors resume to the right of fg. bg grows if fg reaches past its edges.
+func Overlay(bg, fg string, x, y int) string {
+    if fg == "" {
+        return bg
+    }
+    x, y = max(x, 0), max(y, 0)
+
+    bgLines := strings.Split(bg, "\n")
+    fgLines := strings.Split(fg, "\n")
+    fgWidth := lipgloss.Width(fg)
+    for len(bgLines) < y+len(fgLines) {
+        bgLines = append(bgLines, "")
+    }
+
+    for i, line := range fgLines {
+        row := bgLines[y+i]
+        left, right := splitAround(row, x, x+fgWidth)
+        pad := strings.Repeat(" ", fgWidth-lipgloss.Width(line))
+        bgLines[y+i] = left + sgrReset + line + pad + sgrReset + right
+    }
+    return strings.Join(bgLines, "\n")
+}
+
+// OverlayPlace positions fg within bg, e.g. lipgloss.Right, lipgloss.Bottom for a toast corner
+func OverlayPlace(bg, fg string, h, v lipgloss.Position) string {
+    x := int(float64(lipgloss.Width(bg)-lipgloss.Width(fg)) * float64(h))
+    y := int(float64(lipgloss.Height(bg)-lipgloss.Height(fg)) * float64(v))
+    return Overlay(bg, fg, x, y)
+}
+
+// splitAround returns the cells of line before column from and after column to.
+// Wide runes cut by either edge become spaces. Escape sequences keep their
+// place; the right part also replays earlier SGR sequences so it keeps the
+// colors it had under the covered region.
+func splitAround(line string, from, to int) (left, right string) {
+    var l, r, sgr strings.Builder
+    col := 0
+    for _, seg := range segments(line) {
+        if seg.esc {
+            switch {
+            case col < from:
+                l.WriteString(seg.text)
+            case col >= to:
+                r.WriteString(seg.text)
+            default:
+                if !strings.HasSuffix(seg.text, "m") {
+                    // Not a color; place it once, just after the covered cells
+                    r.WriteString(seg.text)
+                }
+            }
+            if strings.HasSuffix(seg.text, "m") && col < to {
+                sgr.WriteString(seg.text)
+            }
+            continue
+        }
+
+        end := col + seg.width
+        switch {
+        case end <= from:
+            l.WriteString(seg.text)
+        case col >= to:
+            r.WriteString(seg.text)
+        case col < from:
+            l.WriteString(strings.Repeat(" ", from-col))
+        case end > to:
+            r.WriteString(strings.Repeat(" ", end-to))
+        }
+        col = end
+    }
+
+    if col < from {
+        l.WriteString(strings.Repeat(" ", from-col))
+    }
+    return l.String(), sgr.String() + r.String()
+}
@@ -0,0 +1,73 @@
+package atoms
+
+import (
+    "math"
+    "time"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+const pulseFPS = time.Second / 20
+
+// pulseFrameMsg advances the pulse with the matching id
+type pulseFrameMsg struct {
+    id int
+}
+
+// Pulse fades content between two colors to draw attention to it. Leave From
+// and To empty to pulse between the theme's Warning and Danger.
+type Pulse struct {
+    From, To lipgloss.Color
+    Period   time.Duration // One full From→To→From cycle
+    frame    int
+    id       int
+}
+
+func NewPulse() Pulse {
+    return Pulse{Period: 1500 * time.Millisecond, id: nextID()}
+}
+
+// Tick starts the animation; with reduced motion it never schedules a frame
+func (p Pulse) Tick() tea.Cmd {
+    if ReducedMotion() {
+        return nil
+    }
+    id := p.id
+    return tea.Tick(pulseFPS, func(time.Time) tea.Msg {
+        return pulseFrameMsg{id: id}
+    })
+}
+
+func (p Pulse) Update(msg tea.Msg) (Pulse, tea.Cmd) {
+    if msg, ok := msg.(pulseFrameMsg); ok && msg.id == p.id {
+        p.frame++
+        return p, p.Tick()
+    }
+    return p, nil
+}
+
+// Color is the current shade, for callers that style something other than the foreground
+func (p Pulse) Color() lipgloss.Color {
+    from, to := p.From, p.To
+    if from == "" {
+        from = theme.Warning
+    }
+    if to == "" {
+        to = theme.Danger
+    }
+    if ReducedMotion() || p.Period <= 0 {
+        return from
+    }
+
+    frames := float64(p.Period) / float64(pulseFPS)
+    phase := math.Mod(float64(p.frame), frames) / frames
+    return theme.Blend(from, to, (1-math.Cos(2*math.Pi*phase))/2)
+}
+
+// Render colors s with the current shade. Text that is already styled keeps
+// its own foreground, so pass plain text or use Color directly.
+func (p Pulse) Render(s string) string {
+    return lipgloss.NewStyle().Foreground(p.Color()).Render(s)
+}
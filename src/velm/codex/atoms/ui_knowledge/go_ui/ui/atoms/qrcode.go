@@ -0,0 +1,486 @@
+package atoms
+
+import (
+    "fmt"
+    "strings"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// QR codes are encoded in byte mode at error correction level M, versions
+// 1-10 (up to 213 bytes), which comfortably fits login and pairing URLs.
+
+// qrBlocks describes the Reed-Solomon layout of one version at level M
+type qrBlocks struct {
+    ec         int // EC codewords per block
+    g1, g1Data int // Blocks and data codewords per block in group 1
+    g2, g2Data int // Same for group 2
+}
+
+var qrVersions = [...]qrBlocks{
+    1:  {10, 1, 16, 0, 0},
+    2:  {16, 1, 28, 0, 0},
+    3:  {26, 1, 44, 0, 0},
+    4:  {18, 2, 32, 0, 0},
+    5:  {24, 2, 43, 0, 0},
+    6:  {16, 4, 27, 0, 0},
+    7:  {18, 4, 31, 0, 0},
+    8:  {22, 2, 38, 2, 39},
+    9:  {22, 3, 36, 2, 37},
+    10: {26, 4, 43, 1, 44},
+}
+
+// Alignment pattern centers per version
+var qrAlignment = [...][]int{
+    1: nil, 2: {6, 18}, 3: {6, 22}, 4: {6, 26}, 5: {6, 30},
+    6: {6, 34}, 7: {6, 22, 38}, 8: {6, 24, 42}, 9: {6, 26, 46}, 10: {6, 28, 50},
+}
+
+const qrQuietZone = 2
+
+// QRCode renders data as a scannable QR code, two modules per terminal row
+// using half blocks. It is drawn black on white regardless of the theme,
+// since scanners need the contrast.
+func QRCode(data string) string {
+    modules, err := encodeQR([]byte(data))
+    if err != nil {
+        return lipgloss.NewStyle().Foreground(theme.Danger).Render(Icon("warning") + " " + err.Error())
+    }
+
+    size := len(modules)
+    dark := func(x, y int) bool {
+        x, y = x-qrQuietZone, y-qrQuietZone
+        return x >= 0 && y >= 0 && x < size && y < size && modules[y][x]
+    }
+
+    style := lipgloss.NewStyle().
+        Foreground(lipgloss.Color("#000000")).
+        Background(lipgloss.Color("#ffffff"))
+
+    total := size + 2*qrQuietZone
+    lines := make([]string, 0, (total+1)/2)
+    for y := 0; y < total; y += 2 {
+        var row strings.Builder
+        for x := 0; x < total; x++ {
+            switch top, bottom := dark(x, y), dark(x, y+1); {
+            case top && bottom:
+                row.WriteString("█")
+            case top:
+                row.WriteString("▀")
+            case bottom:
+                row.WriteString("▄")
+            default:
+                row.WriteString(" ")
+            }
+        }
+        lines = append(lines, style.Render(row.String()))
+    }
+    return strings.Join(lines, "\n")
+}
+
+// encodeQR returns the module matrix for data, true meaning dark
+func encodeQR(data []byte) ([][]bool, error) {
+    version := 0
+    for v := 1; v < len(qrVersions); v++ {
+        if len(data) <= qrCapacity(v) {
+            version = v
+            break
+        }
+    }
+    if version == 0 {
+        return nil, fmt.Errorf("QR payload too long (%d bytes, max %d)", len(data), qrCapacity(len(qrVersions)-1))
+    }
+
+    q := newQRMatrix(version)
+    q.drawFunctionPatterns()
+    q.drawCodewords(qrCodewords(data, version))
+
+    best, bestPenalty := 0, -1
+    for mask := 0; mask < 8; mask++ {
+        q.applyMask(mask)
+        q.drawFormatBits(mask)
+        if p := q.penalty(); bestPenalty < 0 || p < bestPenalty {
+            best, bestPenalty = mask, p
+        }
+        q.applyMask(mask) // XOR again to undo
+    }
+    q.applyMask(best)
+    q.drawFormatBits(best)
+    return q.modules, nil
+}
+
+func qrDataCodewords(version int) int {
+    b := qrVersions[version]
+    return b.g1*b.g1Data + b.g2*b.g2Data
+}
+
+// qrCapacity is the number of bytes a version holds after the mode and length header
+func qrCapacity(version int) int {
+    header := 4 + 8 // Mode indicator and an 8 bit length
+    if version >= 10 {
+        header = 4 + 16
+    }
+    return qrDataCodewords(version) - (header+7)/8
+}
+
+// qrCodewords builds the final interleaved data and error correction codewords
+func qrCodewords(data []byte, version int) []byte {
+    var bits qrBits
+    bits.append(0b0100, 4) // Byte mode
+    if version >= 10 {
+        bits.append(len(data), 16)
+    } else {
+        bits.append(len(data), 8)
+    }
+    for _, b := range data {
+        bits.append(int(b), 8)
+    }
+
+    capacity := qrDataCodewords(version) * 8
+    terminator := capacity - len(bits)
+    if terminator > 4 {
+        terminator = 4
+    }
+    bits.append(0, terminator)
+    bits.append(0, (8-len(bits)%8)%8)
+    for pad := 0; len(bits) < capacity; pad++ {
+        bits.append([]int{0xec, 0x11}[pad%2], 8)
+    }
+    payload := bits.bytes()
+
+    // Split into blocks and compute error correction for each
+    layout := qrVersions[version]
+    divisor := rsDivisor(layout.ec)
+    var blocks, ecc [][]byte
+    for i := 0; i < layout.g1+layout.g2; i++ {
+        n := layout.g1Data
+        if i >= layout.g1 {
+            n = layout.g2Data
+        }
+        blocks = append(blocks, payload[:n])
+        ecc = append(ecc, rsRemainder(payload[:n], divisor))
+        payload = payload[n:]
+    }
+
+    var out []byte
+    for i := 0; i < max(layout.g1Data, layout.g2Data); i++ {
+        for _, blk := range blocks {
+            if i < len(blk) {
+                out = append(out, blk[i])
+            }
+        }
+    }
+    for i := 0; i < layout.ec; i++ {
+        for _, blk := range ecc {
+            out = append(out, blk[i])
+        }
+    }
+    return out
+}
+
+// qrBits is a big-endian bit buffer
+type qrBits []bool
+
+func (b *qrBits) append(v, n int) {
+    for i := n - 1; i >= 0; i-- {
+        *b = append(*b, v>>i&1 == 1)
+    }
+}
+
+func (b qrBits) bytes() []byte {
+    out := make([]byte, len(b)/8)
+    for i, bit := range b {
+        if bit {
+            out[i/8] |= 1 << (7 - i%8)
+        }
+    }
+    return out
+}
+
+// gfMul multiplies in GF(256) with the QR polynomial x^8+x^4+x^3+x^2+1
+func gfMul(x, y byte) byte {
+    var z int
+    for i := 7; i >= 0; i-- {
+        z = z<<1 ^ (z>>7)*0x11d
+        z ^= int(y>>i&1) * int(x)
+    }
+    return byte(z)
+}
+
+// rsDivisor is the Reed-Solomon generator polynomial of the given degree,
+// highest coefficient first with the leading 1 dropped
+func rsDivisor(degree int) []byte {
+    result := make([]byte, degree)
+    result[degree-1] = 1
+    root := byte(1)
+    for i := 0; i < degree; i++ {
+        for j := range result {
+            result[j] = gfMul(result[j], root)
+            if j+1 < len(result) {
+                result[j] ^= result[j+1]
+            }
+        }
+        root = gfMul(root, 0x02)
+    }
+    return result
+}
+
+func rsRemainder(data, divisor []byte) []byte {
+    result := make([]byte, len(divisor))
+    for _, b := range data {
+        factor := b ^ result[0]
+        copy(result, result[1:])
+        result[len(result)-1] = 0
+        for i, d := range divisor {
+            result[i] ^= gfMul(d, factor)
+        }
+    }
+    return result
+}
+
+// qrMatrix holds the modules and which of them belong to function patterns
+type qrMatrix struct {
+    version    int
+    size       int
+    modules    [][]bool
+    isFunction [][]bool
+}
+
+func newQRMatrix(version int) *qrMatrix {
+    size := 17 + 4*version
+    q := &qrMatrix{version: version, size: size}
+    q.modules = make([][]bool, size)
+    q.isFunction = make([][]bool, size)
+    for i := range q.modules {
+        q.modules[i] = make([]bool, size)
+        q.isFunction[i] = make([]bool, size)
+    }
+    return q
+}
+
+// set marks a function module at column x, row y
+func (q *qrMatrix) set(x, y int, dark bool) {
+    q.modules[y][x] = dark
+    q.isFunction[y][x] = true
+}
+
+func (q *qrMatrix) drawFunctionPatterns() {
+    for i := 0; i < q.size; i++ {
+        q.set(6, i, i%2 == 0)
+        q.set(i, 6, i%2 == 0)
+    }
+
+    q.drawFinder(3, 3)
+    q.drawFinder(q.size-4, 3)
+    q.drawFinder(3, q.size-4)
+
+    pos := qrAlignment[q.version]
+    last := len(pos) - 1
+    for i := range pos {
+        for j := range pos {
+            // Skip the three corners occupied by finders
+            if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+                continue
+            }
+            for dy := -2; dy <= 2; dy++ {
+                for dx := -2; dx <= 2; dx++ {
+                    q.set(pos[i]+dx, pos[j]+dy, max(abs(dx), abs(dy)) != 1)
+                }
+            }
+        }
+    }
+
+    q.drawFormatBits(0) // Reserve the area; real bits are drawn after masking
+    q.drawVersion()
+}
+
+// drawFinder draws a finder pattern and its separator centered on (x, y)
+func (q *qrMatrix) drawFinder(x, y int) {
+    for dy := -4; dy <= 4; dy++ {
+        for dx := -4; dx <= 4; dx++ {
+            xx, yy := x+dx, y+dy
+            if xx < 0 || yy < 0 || xx >= q.size || yy >= q.size {
+                continue
+            }
+            dist := max(abs(dx), abs(dy))
+            q.set(xx, yy, dist != 2 && dist != 4)
+        }
+    }
+}
+
+func (q *qrMatrix) drawFormatBits(mask int) {
+    // Level M is 00 in the format field
+    data := mask
+    rem := data
+    for i := 0; i < 10; i++ {
+        rem = rem<<1 ^ (rem>>9)*0x537
+    }
+    bits := (data<<10 | rem) ^ 0x5412
+    bit := func(i int) bool { return bits>>i&1 == 1 }
+
+    // Around the top-left finder
+    for i := 0; i <= 5; i++ {
+        q.set(8, i, bit(i))
+    }
+    q.set(8, 7, bit(6))
+    q.set(8, 8, bit(7))
+    q.set(7, 8, bit(8))
+    for i := 9; i < 15; i++ {
+        q.set(14-i, 8, bit(i))
+    }
+
+    // Split between the top-right and bottom-left finders
+    for i := 0; i < 8; i++ {
+        q.set(q.size-1-i, 8, bit(i))
+    }
+    for i := 8; i < 15; i++ {
+        q.set(8, q.size-15+i, bit(i))
+    }
+    q.set(8, q.size-8, true) // The dark module
+}
+
+func (q *qrMatrix) drawVersion() {
+    if q.version < 7 {
+        return
+    }
+    rem := q.version
+    for i := 0; i < 12; i++ {
+        rem = rem<<1 ^ (rem>>11)*0x1f25
+    }
+    bits := q.version<<12 | rem
+    for i := 0; i < 18; i++ {
+        dark := bits>>i&1 == 1
+        a, b := q.size-11+i%3, i/3
+        q.set(a, b, dark)
+        q.set(b, a, dark)
+    }
+}
+
+// drawCodewords fills the non-function modules in the zigzag order
+func (q *qrMatrix) drawCodewords(data []byte) {
+    i := 0
+    for right := q.size - 1; right >= 1; right -= 2 {
+        if right == 6 {
+            right = 5 // Skip the vertical timing pattern
+        }
+        for vert := 0; vert < q.size; vert++ {
+            for j := 0; j < 2; j++ {
+                x := right - j
+                y := vert
+                if (right+1)&2 == 0 { // Upwards
+                    y = q.size - 1 - vert
+                }
+                if !q.isFunction[y][x] && i < len(data)*8 {
+                    q.modules[y][x] = data[i>>3]>>(7-i&7)&1 == 1
+                    i++
+                }
+            }
+        }
+    }
+}
+
+func (q *qrMatrix) applyMask(mask int) {
+    for y := 0; y < q.size; y++ {
+        for x := 0; x < q.size; x++ {
+            if q.isFunction[y][x] {
+                continue
+            }
+            var invert bool
+            switch mask {
+            case 0:
+                invert = (x+y)%2 == 0
+            case 1:
+                invert = y%2 == 0
+            case 2:
+                invert = x%3 == 0
+            case 3:
+                invert = (x+y)%3 == 0
+            case 4:
+                invert = (x/3+y/2)%2 == 0
+            case 5:
+                invert = x*y%2+x*y%3 == 0
+            case 6:
+                invert = (x*y%2+x*y%3)%2 == 0
+            case 7:
+                invert = ((x+y)%2+x*y%3)%2 == 0
+            }
+            if invert {
+                q.modules[y][x] = !q.modules[y][x]
+            }
+        }
+    }
+}
+
+// penalty scores a masked symbol with the four rules of the QR specification
+func (q *qrMatrix) penalty() int {
+    n := q.size
+    at := func(x, y int, transpose bool) bool {
+        if transpose {
+            return q.modules[x][y]
+        }
+        return q.modules[y][x]
+    }
+
+    score := 0
+    for _, transpose := range []bool{false, true} {
+        for y := 0; y < n; y++ {
+            // Rule 1: runs of five or more modules of one color
+            run := 1
+            for x := 1; x < n; x++ {
+                if at(x, y, transpose) == at(x-1, y, transpose) {
+                    run++
+                    continue
+                }
+                if run >= 5 {
+                    score += 3 + run - 5
+                }
+                run = 1
+            }
+            if run >= 5 {
+                score += 3 + run - 5
+            }
+
+            // Rule 3: finder-like 1:1:3:1:1 patterns with four light modules on one side
+            for x := 0; x+11 <= n; x++ {
+                var bits int
+                for k := 0; k < 11; k++ {
+                    bits <<= 1
+                    if at(x+k, y, transpose) {
+                        bits |= 1
+                    }
+                }
+                if bits == 0b10111010000 || bits == 0b00001011101 {
+                    score += 40
+                }
+            }
+        }
+    }
+
+    // Rule 2: 2x2 blocks of one color
+    dark := 0
+    for y := 0; y < n; y++ {
+        for x := 0; x < n; x++ {
+            c := q.modules[y][x]
+            if c {
+                dark++
+            }
+            if x+1 < n && y+1 < n && c == q.modules[y][x+1] && c == q.modules[y+1][x] && c == q.modules[y+1][x+1] {
+                score += 3
+            }
+        }
+    }
+
+    // Rule 4: deviation of the dark share from 50%, in 5% steps
+    percent := dark * 100 / (n * n)
+    prev := percent / 5 * 5
+    score += min(abs(prev-50), abs(prev+5-50)) / 5 * 10
+    return score
+}
+
+func abs(v int) int {
+    if v < 0 {
+        return -v
+    }
+    return v
+}
@@ -0,0 +1,89 @@
+package atoms
+
+import (
+    "strings"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// RadioGroup is a single-choice list; up/down move the cursor and enter/space select
+type RadioGroup struct {
+    Options  []string
+    cursor   int
+    selected int
+    focused  bool
+}
+
+// NewRadioGroup creates a group with nothing selected
+func NewRadioGroup(options ...string) RadioGroup {
+    return RadioGroup{Options: options, selected: -1}
+}
+
+func (r *RadioGroup) Focus() {
+    r.focused = true
+}
+
+func (r *RadioGroup) Blur() {
+    r.focused = false
+}
+
+func (r RadioGroup) Focused() bool {
+    return r.focused
+}
+
+// Selected returns the index of the chosen option, or -1 when nothing is chosen
+func (r RadioGroup) Selected() int {
+    return r.selected
+}
+
+// Select chooses option i and moves the cursor onto it
+func (r *RadioGroup) Select(i int) {
+    if i < 0 || i >= len(r.Options) {
+        return
+    }
+    r.selected = i
+    r.cursor = i
+}
+
+func (r RadioGroup) Update(msg tea.Msg) (RadioGroup, tea.Cmd) {
+    if !r.focused || len(r.Options) == 0 {
+        return r, nil
+    }
+    if msg, ok := msg.(tea.KeyMsg); ok {
+        switch msg.String() {
+        case "up", "k":
+            if r.cursor > 0 {
+                r.cursor--
+            }
+        case "down", "j":
+            if r.cursor < len(r.Options)-1 {
+                r.cursor++
+            }
+        case "enter", " ":
+            r.selected = r.cursor
+        }
+    }
+    return r, nil
+}
+
+func (r RadioGroup) View() string {
+    lines := make([]string, len(r.Options))
+    for i, opt := range r.Options {
+        mark := lipgloss.NewStyle().Foreground(theme.Subtext).Render("○")
+        if i == r.selected {
+            mark = lipgloss.NewStyle().Foreground(theme.Accent).Render("◉")
+        }
+
+        label := theme.Derive(lipgloss.NewStyle())
+        pointer := " "
+        if r.focused && i == r.cursor {
+            label = label.Foreground(theme.Primary).Bold(true)
+            pointer = lipgloss.NewStyle().Foreground(theme.Primary).Render("›")
+        }
+
+        lines[i] = pointer + " " + mark + " " + label.Render(opt)
+    }
+    return strings.Join(lines, "\n")
+}
@@ -0,0 +1,96 @@
+package atoms
+
+import (
+    "math"
+    "strings"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// Rating renders value out of the given stars, rounded to the nearest half star
+func Rating(value float64, stars int) string {
+    halves := int(math.Round(value * 2))
+    if halves < 0 {
+        halves = 0
+    }
+    if halves > stars*2 {
+        halves = stars * 2
+    }
+
+    lit := lipgloss.NewStyle().Foreground(theme.Warning)
+    dim := lipgloss.NewStyle().Foreground(theme.Border)
+
+    var b strings.Builder
+    for i := 0; i < stars; i++ {
+        switch left := halves - i*2; {
+        case left >= 2:
+            b.WriteString(lit.Render(Icon("star")))
+        case left == 1:
+            b.WriteString(lit.Render(Icon("star-half")))
+        default:
+            b.WriteString(dim.Render(Icon("star-empty")))
+        }
+    }
+    return b.String()
+}
+
+// RatingInput lets the user pick a whole-star rating with left/right or the digit keys
+type RatingInput struct {
+    Max     int
+    value   int
+    focused bool
+}
+
+func NewRatingInput(stars int) RatingInput {
+    return RatingInput{Max: stars}
+}
+
+func (r *RatingInput) Focus() {
+    r.focused = true
+}
+
+func (r *RatingInput) Blur() {
+    r.focused = false
+}
+
+func (r RatingInput) Focused() bool {
+    return r.focused
+}
+
+func (r RatingInput) Value() int {
+    return r.value
+}
+
+func (r *RatingInput) SetValue(v int) {
+    r.value = clamp(v, 0, r.Max)
+}
+
+func (r RatingInput) Update(msg tea.Msg) (RatingInput, tea.Cmd) {
+    if !r.focused {
+        return r, nil
+    }
+    if msg, ok := msg.(tea.KeyMsg); ok {
+        switch key := msg.String(); key {
+        case "left", "h", "-":
+            r.SetValue(r.value - 1)
+        case "right", "l", "+":
+            r.SetValue(r.value + 1)
+        default:
+            if len(key) == 1 && key[0] >= '0' && key[0] <= '9' {
+                r.SetValue(int(key[0] - '0'))
+            }
+        }
+    }
+    return r, nil
+}
+
+func (r RatingInput) View() string {
+    stars := Rating(float64(r.value), r.Max)
+    if r.focused {
+        pointer := lipgloss.NewStyle().Foreground(theme.Primary)
+        return pointer.Render("‹") + stars + pointer.Render("›")
+    }
+    return " " + stars + " "
+}
@@ -0,0 +1,66 @@
+package atoms
+
+import (
+    "fmt"
+    "time"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// AbsTimeFormat is how RelTimeExpanded spells out a timestamp
+const AbsTimeFormat = "2006-01-02 15:04:05"
+
+// RelTimeTickMsg is broadcast once a second so relative timestamps stay fresh
+type RelTimeTickMsg time.Time
+
+// RelTimeTick schedules the shared refresh tick, aligned to the wall clock.
+// Issue it once from Init and again on every RelTimeTickMsg.
+func RelTimeTick() tea.Cmd {
+    return tea.Every(time.Second, func(t time.Time) tea.Msg {
+        return RelTimeTickMsg(t)
+    })
+}
+
+// RelTime renders t relative to now, e.g. "3m ago" or "in 2h"
+func RelTime(t time.Time) string {
+    return lipgloss.NewStyle().Foreground(theme.Subtext).Render(relative(time.Since(t), t))
+}
+
+// RelTimeExpanded renders the absolute timestamp while expanded (on focus or
+// hover) and the relative form otherwise
+func RelTimeExpanded(t time.Time, expanded bool) string {
+    if !expanded {
+        return RelTime(t)
+    }
+    return theme.Derive(lipgloss.NewStyle()).Render(t.Local().Format(AbsTimeFormat))
+}
+
+func relative(d time.Duration, t time.Time) string {
+    future := d < 0
+    if future {
+        d = -d
+    }
+
+    var s string
+    switch {
+    case d < 10*time.Second:
+        return "just now"
+    case d < time.Minute:
+        s = fmt.Sprintf("%ds", int(d.Seconds()))
+    case d < time.Hour:
+        s = fmt.Sprintf("%dm", int(d.Minutes()))
+    case d < 24*time.Hour:
+        s = fmt.Sprintf("%dh", int(d.Hours()))
+    case d < 30*24*time.Hour:
+        s = fmt.Sprintf("%dd", int(d.Hours()/24))
+    default:
+        return t.Local().Format("2006-01-02")
+    }
+
+    if future {
+        return "in " + s
+    }
+    return s + " ago"
+}
@@ -2,18 +2,123 @@ package atoms
 
 import (
     "strings"
+
     "github.com/charmbracelet/lipgloss"
     "gnostic-tui/ui/theme"
 )
 
-func HLine(width int) string {
-    return lipgloss.NewStyle().
-        Foreground(theme.Border).
-        Render(strings.Repeat("─", width))
+// LineStyle picks the weight and pattern of rules and junctions
+type LineStyle int
+
+const (
+    LineThin LineStyle = iota
+    LineHeavy
+    LineDouble
+    LineDashed
+    LineDotted
+)
+
+// lineGlyphs holds the box-drawing runes of one line style
+type lineGlyphs struct {
+    h, v                          string
+    cross                         string
+    teeTop, teeBottom, teeL, teeR string
 }
 
-func VLine(height int) string {
-    return lipgloss.NewStyle().
-        Foreground(theme.Border).
-        Render(strings.Repeat("│\\n", height))
-}
\ No newline at end of file
+var lineSets = map[LineStyle]lineGlyphs{
+    LineThin:   {"─", "│", "┼", "┬", "┴", "├", "┤"},
+    LineHeavy:  {"━", "┃", "╋", "┳", "┻", "┣", "┫"},
+    LineDouble: {"═", "║", "╬", "╦", "╩", "╠", "╣"},
+    // Box drawing has no dashed junctions, so those fall back to thin ones
+    LineDashed: {"╌", "╎", "┼", "┬", "┴", "├", "┤"},
+    LineDotted: {"┈", "┊", "┼", "┬", "┴", "├", "┤"},
+}
+
+// lineSet resolves the optional style argument shared by the separator helpers
+func lineSet(style []LineStyle) lineGlyphs {
+    if len(style) > 0 {
+        if g, ok := lineSets[style[0]]; ok {
+            return g
+        }
+    }
+    return lineSets[LineThin]
+}
+
+func ruleStyle() lipgloss.Style {
+    return lipgloss.NewStyle().Foreground(theme.Border)
+}
+
+// HLine renders a horizontal rule, thin unless a style is given
+func HLine(width int, style ...LineStyle) string {
+    if width <= 0 {
+        return ""
+    }
+    return ruleStyle().Render(strings.Repeat(lineSet(style).h, width))
+}
+
+// VLine renders a vertical rule of height rows, thin unless a style is given
+func VLine(height int, style ...LineStyle) string {
+    if height <= 0 {
+        return ""
+    }
+    rows := make([]string, height)
+    for i := range rows {
+        rows[i] = lineSet(style).v
+    }
+    return ruleStyle().Render(strings.Join(rows, "\n"))
+}
+
+// TeeSide names the edge a tee junction sits on
+type TeeSide int
+
+const (
+    TeeTop    TeeSide = iota // ┬, where a column rule meets the top border
+    TeeBottom                // ┴
+    TeeLeft                  // ├, where a row rule meets the left border
+    TeeRight                 // ┤
+)
+
+// Tee renders the junction joining a rule to a border on the given side
+func Tee(side TeeSide, style ...LineStyle) string {
+    g := lineSet(style)
+    t := g.teeTop
+    switch side {
+    case TeeBottom:
+        t = g.teeBottom
+    case TeeLeft:
+        t = g.teeL
+    case TeeRight:
+        t = g.teeR
+    }
+    return ruleStyle().Render(t)
+}
+
+// Cross renders the junction where a row rule and a column rule meet
+func Cross(style ...LineStyle) string {
+    return ruleStyle().Render(lineSet(style).cross)
+}
+
+// HLineWithLabel renders a rule with a title set into it, e.g. "── Settings ──────".
+// align takes lipgloss.Left, lipgloss.Center or lipgloss.Right.
+func HLineWithLabel(label string, width int, align lipgloss.Position, style ...LineStyle) string {
+    const lead = 2 // Rule kept before a left or after a right aligned label
+
+    title := " " + Truncate(label, width-2*lead-2, "…") + " "
+    rest := width - lipgloss.Width(title)
+    if label == "" || rest < 0 {
+        return HLine(width, style...)
+    }
+
+    var left int
+    switch align {
+    case lipgloss.Left:
+        left = lead
+    case lipgloss.Right:
+        left = rest - lead
+    default:
+        left = rest / 2
+    }
+    left = clamp(left, 0, rest)
+
+    return HLine(left, style...) + theme.Label.Render(title) + HLine(rest-left, style...)
+}
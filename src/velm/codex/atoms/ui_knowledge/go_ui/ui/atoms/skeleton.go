@@ -0,0 +1,67 @@
+package atoms
+
+import (
+    "strings"
+    "time"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+const (
+    shimmerBand  = 6                     // Cells lit by the moving highlight
+    shimmerSpeed = 60 * time.Millisecond // Time for the highlight to advance one cell
+)
+
+// SkeletonTickMsg asks the program to redraw skeleton placeholders
+type SkeletonTickMsg time.Time
+
+// SkeletonTick schedules the next shimmer frame; re-issue it on every SkeletonTickMsg while loading
+func SkeletonTick() tea.Cmd {
+    return tea.Tick(shimmerSpeed, func(t time.Time) tea.Msg {
+        return SkeletonTickMsg(t)
+    })
+}
+
+// Skeleton renders lines of placeholder blocks with a shimmer sweeping across them.
+// The last line is shortened so multi-line placeholders read as a paragraph.
+func Skeleton(width, lines int) string {
+    if width <= 0 || lines <= 0 {
+        return ""
+    }
+
+    base := lipgloss.NewStyle().Foreground(theme.Surface)
+    lit := lipgloss.NewStyle().Foreground(theme.Border)
+
+    // The phase comes from the wall clock so every placeholder on screen shimmers in step
+    phase := int(time.Now().UnixNano()/int64(shimmerSpeed)) % (width + shimmerBand)
+    start, end := phase-shimmerBand, phase
+    if ReducedMotion() {
+        start, end = 0, 0
+    }
+
+    rows := make([]string, lines)
+    for i := range rows {
+        w := width
+        if lines > 1 && i == lines-1 {
+            w = width * 3 / 5
+        }
+
+        from, to := clamp(start, 0, w), clamp(end, 0, w)
+        rows[i] = base.Render(strings.Repeat("█", from)) +
+            lit.Render(strings.Repeat("█", to-from)) +
+            base.Render(strings.Repeat("█", w-to))
+    }
+    return strings.Join(rows, "\n")
+}
+
+func clamp(v, lo, hi int) int {
+    if v < lo {
+        return lo
+    }
+    if v > hi {
+        return hi
+    }
+    return v
+}
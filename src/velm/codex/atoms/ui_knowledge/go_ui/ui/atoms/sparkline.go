@@ -0,0 +1,35 @@
+package atoms
+
+import (
+    "math"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// Sparkline renders the most recent width values as a row of bar glyphs scaled
+// between their minimum and maximum. A width of zero uses every value; a flat
+// series sits on the baseline.
+func Sparkline(values []float64, width int) string {
+    if width > 0 && len(values) > width {
+        values = values[len(values)-width:]
+    }
+    if len(values) == 0 {
+        return ""
+    }
+
+    lo, hi := math.Inf(1), math.Inf(-1)
+    for _, v := range values {
+        lo, hi = math.Min(lo, v), math.Max(hi, v)
+    }
+
+    bars := make([]rune, len(values))
+    for i, v := range values {
+        level := 0
+        if hi > lo {
+            level = int((v - lo) / (hi - lo) * float64(len(gaugeRamp)-1))
+        }
+        bars[i] = gaugeRamp[level]
+    }
+    return lipgloss.NewStyle().Foreground(theme.Accent).Render(string(bars))
+}
@@ -1,15 +1,70 @@
 package atoms
 
 import (
+    "time"
+
     "github.com/charmbracelet/bubbles/spinner"
     "github.com/charmbracelet/lipgloss"
     "gnostic-tui/ui/theme"
 )
 
-// NewGnosticSpinner creates a styled spinner model
-func NewGnosticSpinner() spinner.Model {
+// SpinnerKind picks one of the built-in spinner animations
+type SpinnerKind int
+
+const (
+    SpinnerDot SpinnerKind = iota
+    SpinnerLine
+    SpinnerGlobe
+    SpinnerPulse
+    SpinnerBrailleBar
+)
+
+// brailleBar is a three cell level meter sweeping left to right
+var brailleBar = spinner.Spinner{
+    Frames: []string{"⣀⣀⣀", "⣤⣀⣀", "⣶⣤⣀", "⣿⣶⣤", "⣶⣿⣶", "⣤⣶⣿", "⣀⣤⣶", "⣀⣀⣤"},
+    FPS:    time.Second / 12,
+}
+
+var spinnerCatalog = map[SpinnerKind]spinner.Spinner{
+    SpinnerDot:        spinner.Dot,
+    SpinnerLine:       spinner.Line,
+    SpinnerGlobe:      spinner.Globe,
+    SpinnerPulse:      spinner.Pulse,
+    SpinnerBrailleBar: brailleBar,
+}
+
+// SpinnerOption customizes a spinner built by NewGnosticSpinner
+type SpinnerOption func(*spinner.Model)
+
+// WithSpinnerKind selects an animation from the catalog
+func WithSpinnerKind(kind SpinnerKind) SpinnerOption {
+    return func(s *spinner.Model) {
+        if sp, ok := spinnerCatalog[kind]; ok {
+            s.Spinner = sp
+        }
+    }
+}
+
+// WithFrames animates the spinner with custom frames at fps frames per second
+func WithFrames(frames []string, fps int) SpinnerOption {
+    return func(s *spinner.Model) {
+        if len(frames) == 0 {
+            return
+        }
+        if fps <= 0 {
+            fps = 10
+        }
+        s.Spinner = spinner.Spinner{Frames: frames, FPS: time.Second / time.Duration(fps)}
+    }
+}
+
+// NewGnosticSpinner creates a styled spinner model, a Dot unless options say otherwise
+func NewGnosticSpinner(opts ...SpinnerOption) spinner.Model {
     s := spinner.New()
     s.Spinner = spinner.Dot
     s.Style = lipgloss.NewStyle().Foreground(theme.Secondary)
+    for _, opt := range opts {
+        opt(&s)
+    }
     return s
-}
\ No newline at end of file
+}
@@ -0,0 +1,29 @@
+package atoms
+
+import (
+    "strings"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// StepDots renders "● ● ○ ○" progress for a short multi-step flow. current is
+// the zero-based active step; earlier steps show as done.
+func StepDots(current, total int) string {
+    done := lipgloss.NewStyle().Foreground(theme.Accent)
+    active := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+    pending := lipgloss.NewStyle().Foreground(theme.Border)
+
+    dots := make([]string, total)
+    for i := range dots {
+        switch {
+        case i < current:
+            dots[i] = done.Render("●")
+        case i == current:
+            dots[i] = active.Render("●")
+        default:
+            dots[i] = pending.Render("○")
+        }
+    }
+    return strings.Join(dots, " ")
+}
@@ -0,0 +1,16 @@
+package atoms
+
+import (
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// Swatch renders a block of color followed by its label and value
+func Swatch(color lipgloss.Color, label string) string {
+    block := lipgloss.NewStyle().Background(color).Render("    ")
+    value := theme.Caption.Render(string(color))
+    if label == "" {
+        return block + " " + value
+    }
+    return block + " " + theme.Derive(lipgloss.NewStyle()).Render(label) + " " + value
+}
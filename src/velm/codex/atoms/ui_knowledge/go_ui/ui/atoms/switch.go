@@ -0,0 +1,131 @@
+package atoms
+
+import (
+    "strings"
+    "time"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+const (
+    switchTrack = 5 // Cells inside the pill
+    switchKnob  = 2 // Cells covered by the knob
+    switchFPS   = time.Second / 30
+)
+
+// switchFrameMsg advances the slide animation of the switch with the matching
+// id, if no later toggle has started another
+type switchFrameMsg struct {
+    id  int
+    tag int
+}
+
+// Switch is a pill-shaped on/off control whose knob slides between states
+type Switch struct {
+    Label   string
+    On      bool
+    focused bool
+    pos     int // Knob offset, 0 (off) .. switchTrack-switchKnob (on)
+    tag     int // Counts toggles, so frames of an earlier slide are ignored
+    id      int
+}
+
+func NewSwitch(label string) Switch {
+    return Switch{Label: label, id: nextID()}
+}
+
+func (s *Switch) Focus() {
+    s.focused = true
+}
+
+func (s *Switch) Blur() {
+    s.focused = false
+}
+
+func (s Switch) Focused() bool {
+    return s.focused
+}
+
+// SetOn sets the state immediately, without animating the knob
+func (s *Switch) SetOn(on bool) {
+    s.On = on
+    s.pos = s.target()
+}
+
+// Toggle flips the switch and starts the slide towards the new state.
+// With reduced motion the knob jumps straight there.
+func (s *Switch) Toggle() tea.Cmd {
+    if ReducedMotion() {
+        s.SetOn(!s.On)
+        return nil
+    }
+    s.On = !s.On
+    s.tag++
+    return s.frame()
+}
+
+func (s Switch) target() int {
+    if s.On {
+        return switchTrack - switchKnob
+    }
+    return 0
+}
+
+func (s Switch) frame() tea.Cmd {
+    id, tag := s.id, s.tag
+    return tea.Tick(switchFPS, func(time.Time) tea.Msg {
+        return switchFrameMsg{id: id, tag: tag}
+    })
+}
+
+func (s Switch) Update(msg tea.Msg) (Switch, tea.Cmd) {
+    switch msg := msg.(type) {
+    case switchFrameMsg:
+        if msg.id != s.id || msg.tag != s.tag {
+            return s, nil
+        }
+        switch target := s.target(); {
+        case s.pos < target:
+            s.pos++
+        case s.pos > target:
+            s.pos--
+        }
+        if s.pos != s.target() {
+            return s, s.frame()
+        }
+    case tea.KeyMsg:
+        if s.focused && (msg.String() == " " || msg.String() == "enter") {
+            return s, s.Toggle()
+        }
+    }
+    return s, nil
+}
+
+func (s Switch) View() string {
+    track := theme.Border
+    state := "Off"
+    if s.On {
+        track = theme.Accent
+        state = "On"
+    }
+
+    bg := lipgloss.NewStyle().Background(track)
+    knob := lipgloss.NewStyle().Background(track).Foreground(theme.Text)
+    pill := bg.Render(strings.Repeat(" ", s.pos)) +
+        knob.Render(strings.Repeat("█", switchKnob)) +
+        bg.Render(strings.Repeat(" ", switchTrack-switchKnob-s.pos))
+
+    label := theme.Derive(lipgloss.NewStyle())
+    caption := theme.Caption
+    if s.focused {
+        label = label.Foreground(theme.Primary).Bold(true)
+        pill = lipgloss.NewStyle().Foreground(theme.Primary).Render("‹") + pill +
+            lipgloss.NewStyle().Foreground(theme.Primary).Render("›")
+    } else {
+        pill = " " + pill + " "
+    }
+
+    return pill + " " + caption.Render(state) + " " + label.Render(s.Label)
+}
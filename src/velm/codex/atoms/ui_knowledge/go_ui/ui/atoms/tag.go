@@ -0,0 +1,53 @@
+package atoms
+
+import (
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+type tagConfig struct {
+    variant   BadgeVariant
+    removable bool
+    selected  bool
+}
+
+// TagOption customizes a Tag
+type TagOption func(*tagConfig)
+
+// TagVariant colors the chip like the matching badge variant
+func TagVariant(variant BadgeVariant) TagOption {
+    return func(c *tagConfig) { c.variant = variant }
+}
+
+// TagRemovable adds a close glyph to the chip
+func TagRemovable() TagOption {
+    return func(c *tagConfig) { c.removable = true }
+}
+
+// TagSelected highlights the chip, e.g. when it is the next one to be removed
+func TagSelected() TagOption {
+    return func(c *tagConfig) { c.selected = true }
+}
+
+// Tag renders a compact chip for labels and filters
+func Tag(text string, opts ...TagOption) string {
+    var cfg tagConfig
+    for _, opt := range opts {
+        opt(&cfg)
+    }
+
+    color := variantColor(cfg.variant)
+    style := lipgloss.NewStyle().
+        Padding(0, 1).
+        Background(theme.Surface).
+        Foreground(color)
+
+    if cfg.selected {
+        style = style.Background(color).Foreground(theme.Surface).Bold(true)
+    }
+
+    if cfg.removable {
+        text += " ✕"
+    }
+    return style.Render(text)
+}
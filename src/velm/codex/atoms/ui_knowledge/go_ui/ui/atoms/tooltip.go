@@ -0,0 +1,59 @@
+package atoms
+
+import (
+    "strings"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// TooltipPlacement says which side of its anchor a tooltip opens on
+type TooltipPlacement int
+
+const (
+    TooltipAbove TooltipPlacement = iota
+    TooltipBelow
+)
+
+// Tooltip is a small hint box shown next to a component while it is focused or hovered
+type Tooltip struct {
+    Text      string
+    Placement TooltipPlacement
+    Visible   bool
+}
+
+func NewTooltip(text string) Tooltip {
+    return Tooltip{Text: text}
+}
+
+func (t *Tooltip) Show() {
+    t.Visible = true
+}
+
+func (t *Tooltip) Hide() {
+    t.Visible = false
+}
+
+// Render anchors the hint to an already rendered component. A hidden
+// tooltip returns the anchor untouched.
+func (t Tooltip) Render(anchor string) string {
+    if !t.Visible || t.Text == "" {
+        return anchor
+    }
+
+    box := lipgloss.NewStyle().
+        Border(lipgloss.RoundedBorder()).
+        BorderForeground(theme.Border).
+        Foreground(theme.Text).
+        Background(theme.Surface).
+        Padding(0, 1).
+        Render(t.Text)
+
+    // A pointer nudged in from the left edge ties the box to its anchor
+    pointer := strings.Repeat(" ", 2) + lipgloss.NewStyle().Foreground(theme.Border).Render("▼")
+    if t.Placement == TooltipBelow {
+        pointer = strings.Repeat(" ", 2) + lipgloss.NewStyle().Foreground(theme.Border).Render("▲")
+        return lipgloss.JoinVertical(lipgloss.Left, anchor, pointer, box)
+    }
+    return lipgloss.JoinVertical(lipgloss.Left, box, pointer, anchor)
+}
@@ -0,0 +1,161 @@
+package atoms
+
+import (
+    "strings"
+    "unicode/utf8"
+
+    "github.com/charmbracelet/lipgloss"
+)
+
+// segment is either one printable rune or one whole escape sequence
+type segment struct {
+    text  string
+    width int
+    esc   bool
+}
+
+// segments splits a single line into printable runes and escape sequences
+func segments(s string) []segment {
+    var out []segment
+    for i := 0; i < len(s); {
+        if s[i] == '\x1b' {
+            n := escapeLen(s[i:])
+            out = append(out, segment{text: s[i : i+n], esc: true})
+            i += n
+            continue
+        }
+        r, size := utf8.DecodeRuneInString(s[i:])
+        out = append(out, segment{text: s[i : i+size], width: lipgloss.Width(string(r))})
+        i += size
+    }
+    return out
+}
+
+// escapeLen measures the escape sequence at the start of s: OSC runs to BEL
+// or ST, anything else to the first terminator letter.
+func escapeLen(s string) int {
+    if len(s) >= 2 && s[1] == ']' {
+        for i := 2; i < len(s); i++ {
+            if s[i] == '\a' {
+                return i + 1
+            }
+            if s[i] == '\x1b' && i+1 < len(s) && s[i+1] == '\\' {
+                return i + 2
+            }
+        }
+        return len(s)
+    }
+    for i := 1; i < len(s); i++ {
+        if c := s[i]; (c >= 0x40 && c <= 0x5a) || (c >= 0x61 && c <= 0x7a) {
+            return i + 1
+        }
+    }
+    return len(s)
+}
+
+// Truncate shortens every line of s to at most width cells, ending cut
+// lines with ellipsis. Wide runes are never split and escape sequences are
+// kept intact, including those in the cut-off tail, so styles still reset.
+func Truncate(s string, width int, ellipsis string) string {
+    return eachLine(s, func(line string) string {
+        return truncateLine(line, width, ellipsis, false)
+    })
+}
+
+// TruncateMiddle is Truncate but keeps both ends of the line, which suits
+// paths and identifiers: "/usr/lo…/bin/app"
+func TruncateMiddle(s string, width int, ellipsis string) string {
+    return eachLine(s, func(line string) string {
+        return truncateLine(line, width, ellipsis, true)
+    })
+}
+
+func eachLine(s string, fn func(string) string) string {
+    lines := strings.Split(s, "\n")
+    for i, line := range lines {
+        lines[i] = fn(line)
+    }
+    return strings.Join(lines, "\n")
+}
+
+func truncateLine(line string, width int, ellipsis string, middle bool) string {
+    if width <= 0 {
+        return ""
+    }
+    if lipgloss.Width(line) <= width {
+        return line
+    }
+
+    room := width - lipgloss.Width(ellipsis)
+    if room < 0 {
+        // Not even the ellipsis fits; cut it down instead
+        return truncateLine(ellipsis, width, "", false)
+    }
+
+    segs := segments(line)
+    head, tail := room, 0
+    if middle {
+        head, tail = (room+1)/2, room/2
+    }
+
+    // Claim printable segments from the front, then from the back
+    keep := make([]bool, len(segs))
+    used := 0
+    for i, seg := range segs {
+        if seg.esc {
+            continue
+        }
+        if used+seg.width > head {
+            break
+        }
+        keep[i] = true
+        used += seg.width
+    }
+    used = 0
+    for i := len(segs) - 1; i >= 0 && tail > 0; i-- {
+        if segs[i].esc || keep[i] {
+            continue
+        }
+        if used+segs[i].width > tail {
+            break
+        }
+        keep[i] = true
+        used += segs[i].width
+    }
+
+    var b strings.Builder
+    cut := false
+    for i, seg := range segs {
+        switch {
+        case seg.esc:
+            b.WriteString(seg.text)
+        case keep[i]:
+            b.WriteString(seg.text)
+        case !cut:
+            b.WriteString(ellipsis)
+            cut = true
+        }
+    }
+    return b.String()
+}
+
+// Cut returns the cells of a single line from start up to start+width, for
+// scrolling and wrapping styled text. Escape sequences are all kept so
+// styles carry over, and a wide rune split by either edge becomes a space.
+func Cut(line string, start, width int) string {
+    var b strings.Builder
+    col, end := 0, start+width
+    for _, seg := range segments(line) {
+        switch {
+        case seg.esc:
+            b.WriteString(seg.text)
+            continue
+        case col >= start && col+seg.width <= end:
+            b.WriteString(seg.text)
+        case col < end && col+seg.width > start:
+            b.WriteString(strings.Repeat(" ", min(col+seg.width, end)-max(col, start)))
+        }
+        col += seg.width
+    }
+    return b.String()
+}
@@ -0,0 +1,179 @@
+package molecules
+
+import (
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
+)
+
+// AlertOption customises an Alert
+type AlertOption func(*alertConfig)
+
+type alertConfig struct {
+    width      int
+    dismissKey string
+    zoneID     string
+}
+
+// AlertWidth sets the total width; the body wraps inside it
+func AlertWidth(w int) AlertOption {
+    return func(c *alertConfig) { c.width = w }
+}
+
+// AlertDismissKey shows a "✕ key" hint in the title row
+func AlertDismissKey(key string) AlertOption {
+    return func(c *alertConfig) { c.dismissKey = key }
+}
+
+// alertZone marks the dismiss hint so a click on it can close the alert
+func alertZone(id string) AlertOption {
+    return func(c *alertConfig) { c.zoneID = id }
+}
+
+// alertColor is the accent of a variant: border, icon and title
+func alertColor(v atoms.BadgeVariant) lipgloss.Color {
+    switch v {
+    case atoms.BadgeSuccess:
+        return theme.Accent
+    case atoms.BadgeWarning:
+        return theme.Warning
+    case atoms.BadgeDanger:
+        return theme.Danger
+    default:
+        return theme.Primary
+    }
+}
+
+func alertIcon(v atoms.BadgeVariant) string {
+    switch v {
+    case atoms.BadgeSuccess:
+        return atoms.Icon("check")
+    case atoms.BadgeWarning:
+        return atoms.Icon("warning")
+    case atoms.BadgeDanger:
+        return atoms.Icon("cross")
+    default:
+        return atoms.Icon("info")
+    }
+}
+
+// Alert renders a callout: a colored bar down the left edge, the variant icon
+// and title, then the body
+func Alert(title, body string, variant atoms.BadgeVariant, opts ...AlertOption) string {
+    cfg := alertConfig{width: 50}
+    for _, opt := range opts {
+        opt(&cfg)
+    }
+
+    color := alertColor(variant)
+    inner := max(cfg.width-1-theme.Space(2), 1) // Bar and padding
+
+    head := lipgloss.NewStyle().Foreground(color).Bold(true).
+        Render(alertIcon(variant) + " " + title)
+    if cfg.dismissKey != "" {
+        hint := theme.Caption.Render("✕ " + cfg.dismissKey)
+        if cfg.zoneID != "" {
+            hint = zone.Mark(cfg.zoneID, hint)
+        }
+        gap := max(inner-lipgloss.Width(head)-lipgloss.Width(hint), 1)
+        head = head + lipgloss.NewStyle().Width(gap).Render("") + hint
+    }
+
+    rows := []string{head}
+    if body != "" {
+        rows = append(rows, theme.Derive(lipgloss.NewStyle().Width(inner)).Render(body))
+    }
+
+    return lipgloss.NewStyle().
+        Border(lipgloss.ThickBorder(), false, false, false, true).
+        BorderForeground(color).
+        Padding(0, theme.Space(1)).
+        Width(cfg.width - 1).
+        Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// AlertDismissedMsg is emitted when a DismissibleAlert is closed
+type AlertDismissedMsg struct {
+    ID string
+}
+
+// DismissibleAlert is an Alert that closes on its key (esc by default) while
+// focused, or on a click on its ✕ hint. Once dismissed its View is empty.
+type DismissibleAlert struct {
+    Title, Body string
+    Variant     atoms.BadgeVariant
+    Width       int
+    Key         string
+    dismissed   bool
+    focused     bool
+    id          string
+}
+
+func NewDismissibleAlert(title, body string, variant atoms.BadgeVariant) DismissibleAlert {
+    return DismissibleAlert{
+        Title:   title,
+        Body:    body,
+        Variant: variant,
+        Width:   50,
+        Key:     "esc",
+        id:      zone.NewID("alert"),
+    }
+}
+
+// ID identifies the alert in AlertDismissedMsg
+func (a DismissibleAlert) ID() string {
+    return a.id
+}
+
+func (a *DismissibleAlert) Focus() {
+    a.focused = true
+}
+
+func (a *DismissibleAlert) Blur() {
+    a.focused = false
+}
+
+func (a DismissibleAlert) Focused() bool {
+    return a.focused
+}
+
+func (a DismissibleAlert) Dismissed() bool {
+    return a.dismissed
+}
+
+// Show brings a dismissed alert back
+func (a *DismissibleAlert) Show() {
+    a.dismissed = false
+}
+
+func (a *DismissibleAlert) dismiss() tea.Cmd {
+    a.dismissed = true
+    msg := AlertDismissedMsg{ID: a.id}
+    return func() tea.Msg { return msg }
+}
+
+func (a DismissibleAlert) Update(msg tea.Msg) (DismissibleAlert, tea.Cmd) {
+    if a.dismissed {
+        return a, nil
+    }
+    switch msg := msg.(type) {
+    case tea.MouseMsg:
+        if zone.Get(a.id).Clicked(msg) {
+            return a, a.dismiss()
+        }
+    case tea.KeyMsg:
+        if a.focused && msg.String() == a.Key {
+            return a, a.dismiss()
+        }
+    }
+    return a, nil
+}
+
+func (a DismissibleAlert) View() string {
+    if a.dismissed {
+        return ""
+    }
+    return Alert(a.Title, a.Body, a.Variant, AlertWidth(a.Width), AlertDismissKey(a.Key), alertZone(a.id))
+}
@@ -0,0 +1,168 @@
+package molecules
+
+import (
+    "strings"
+
+    "github.com/charmbracelet/bubbles/textinput"
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
+)
+
+// SuggestionsMsg carries the answer of an Async suggester back to its input
+type SuggestionsMsg struct {
+    id          string
+    Query       string
+    Suggestions []string
+}
+
+// Autocomplete is a search input with a ranked suggestion list under it.
+// up/down pick a suggestion, tab or enter accepts it, esc hides the list.
+// The list is drawn by Overlay once the frame has been through zone.Scan.
+type Autocomplete struct {
+    textinput.Model
+    Suggester      Suggester
+    MaxSuggestions int
+    suggestions    []string
+    cursor         int // -1 while no suggestion is highlighted
+    hidden         bool
+    id             string
+}
+
+func NewAutocomplete(s Suggester) Autocomplete {
+    return Autocomplete{
+        Model:          searchTextInput(),
+        Suggester:      s,
+        MaxSuggestions: 6,
+        cursor:         -1,
+        id:             zone.NewID("autocomplete"),
+    }
+}
+
+// Suggestions returns the current ranked list, already capped
+func (a Autocomplete) Suggestions() []string {
+    return a.suggestions
+}
+
+func (a Autocomplete) showing() bool {
+    return !a.hidden && a.Focused() && len(a.suggestions) > 0
+}
+
+// refresh asks the suggester about the current value
+func (a *Autocomplete) refresh() tea.Cmd {
+    a.cursor = -1
+    a.hidden = false
+    query := a.Value()
+    if query == "" || a.Suggester == nil {
+        a.suggestions = nil
+        return nil
+    }
+
+    if async, ok := a.Suggester.(asyncSuggester); ok {
+        id := a.id
+        return func() tea.Msg {
+            return SuggestionsMsg{id: id, Query: query, Suggestions: async.Suggest(query)}
+        }
+    }
+    a.setSuggestions(a.Suggester.Suggest(query))
+    return nil
+}
+
+func (a *Autocomplete) setSuggestions(s []string) {
+    if a.MaxSuggestions > 0 && len(s) > a.MaxSuggestions {
+        s = s[:a.MaxSuggestions]
+    }
+    a.suggestions = s
+}
+
+func (a *Autocomplete) accept() {
+    a.SetValue(a.suggestions[a.cursor])
+    a.CursorEnd()
+    a.suggestions = nil
+    a.cursor = -1
+}
+
+func (a Autocomplete) Update(msg tea.Msg) (Autocomplete, tea.Cmd) {
+    switch msg := msg.(type) {
+    case SuggestionsMsg:
+        if msg.id == a.id && msg.Query == a.Value() {
+            a.setSuggestions(msg.Suggestions)
+        }
+        return a, nil
+    case tea.KeyMsg:
+        if a.showing() {
+            switch msg.String() {
+            case "up", "ctrl+p":
+                a.cursor = max(a.cursor-1, 0)
+                return a, nil
+            case "down", "ctrl+n":
+                a.cursor = min(a.cursor+1, len(a.suggestions)-1)
+                return a, nil
+            case "tab":
+                a.cursor = max(a.cursor, 0)
+                a.accept()
+                return a, nil
+            case "enter":
+                if a.cursor >= 0 {
+                    a.accept()
+                    return a, nil
+                }
+            case "esc":
+                a.hidden = true
+                return a, nil
+            }
+        }
+    }
+
+    before := a.Value()
+    var cmd tea.Cmd
+    a.Model, cmd = a.Model.Update(msg)
+    if a.Value() != before {
+        cmd = tea.Batch(cmd, a.refresh())
+    }
+    return a, cmd
+}
+
+func (a Autocomplete) View() string {
+    return zone.Mark(a.id, a.Model.View())
+}
+
+// Menu renders the suggestion list, or "" when there is nothing to show
+func (a Autocomplete) Menu() string {
+    if !a.showing() {
+        return ""
+    }
+
+    width := 0
+    for _, s := range a.suggestions {
+        width = max(width, lipgloss.Width(s))
+    }
+    width = min(width, max(a.Width, 10))
+
+    rows := make([]string, len(a.suggestions))
+    for i, s := range a.suggestions {
+        style := theme.Derive(lipgloss.NewStyle().Width(width))
+        if i == a.cursor {
+            style = style.Copy().Background(theme.Primary).Foreground(lipgloss.Color("229")).Bold(true)
+        }
+        rows[i] = style.Render(atoms.Truncate(s, width, "…"))
+    }
+
+    return lipgloss.NewStyle().
+        Border(lipgloss.RoundedBorder()).
+        BorderForeground(theme.Border).
+        Render(strings.Join(rows, "\n"))
+}
+
+// Overlay draws the suggestion list under the input in a scanned frame,
+// aligned with the text rather than the prompt
+func (a Autocomplete) Overlay(screen string) string {
+    z := zone.Get(a.id)
+    if z.IsZero() {
+        return screen
+    }
+    x := z.StartX + lipgloss.Width(a.Prompt) - 1 // Border column
+    return atoms.Overlay(screen, a.Menu(), max(x, 0), z.EndY+1)
+}
@@ -0,0 +1,181 @@
+package molecules
+
+import (
+    "strconv"
+    "strings"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
+)
+
+const crumbSeparator = " › "
+
+// Breadcrumbs renders a path such as "home › projects › velm". When it does not
+// fit in width, middle segments collapse into "…", keeping the root and as many
+// of the nearest ancestors as possible. A width of zero never collapses.
+func Breadcrumbs(segments []string, width int) string {
+    return renderCrumbs(segments, width, -1, "")
+}
+
+// BreadcrumbSelectedMsg is emitted when the user navigates back to an ancestor
+type BreadcrumbSelectedMsg struct {
+    Index   int
+    Segment string
+}
+
+// BreadcrumbBar is the interactive form of Breadcrumbs: left/right (h/l) move
+// between segments, enter or a click jumps back to one, dropping the
+// segments after it.
+type BreadcrumbBar struct {
+    Segments []string
+    Width    int
+    cursor   int
+    focused  bool
+    id       string
+}
+
+func NewBreadcrumbBar(segments ...string) BreadcrumbBar {
+    return BreadcrumbBar{Segments: segments, cursor: len(segments) - 1, id: zone.NewID("crumbs")}
+}
+
+func (b *BreadcrumbBar) Focus() {
+    b.focused = true
+}
+
+func (b *BreadcrumbBar) Blur() {
+    b.focused = false
+}
+
+func (b BreadcrumbBar) Focused() bool {
+    return b.focused
+}
+
+// Push appends a segment, e.g. when descending into a directory
+func (b *BreadcrumbBar) Push(segment string) {
+    b.Segments = append(b.Segments, segment)
+    b.cursor = len(b.Segments) - 1
+}
+
+// zoneID is the mouse zone of segment i
+func (b BreadcrumbBar) zoneID(i int) string {
+    return b.id + ":" + strconv.Itoa(i)
+}
+
+func (b *BreadcrumbBar) jump(i int) tea.Cmd {
+    b.Segments = b.Segments[:i+1]
+    b.cursor = i
+    msg := BreadcrumbSelectedMsg{Index: i, Segment: b.Segments[i]}
+    return func() tea.Msg { return msg }
+}
+
+func (b BreadcrumbBar) Update(msg tea.Msg) (BreadcrumbBar, tea.Cmd) {
+    if len(b.Segments) == 0 {
+        return b, nil
+    }
+    b.cursor = clamp(b.cursor, 0, len(b.Segments)-1)
+
+    switch msg := msg.(type) {
+    case tea.MouseMsg:
+        for i := range b.Segments {
+            if zone.Get(b.zoneID(i)).Clicked(msg) {
+                return b, b.jump(i)
+            }
+        }
+    case tea.KeyMsg:
+        if !b.focused {
+            return b, nil
+        }
+        switch msg.String() {
+        case "left", "h":
+            b.cursor = max(b.cursor-1, 0)
+        case "right", "l":
+            b.cursor = min(b.cursor+1, len(b.Segments)-1)
+        case "enter":
+            return b, b.jump(b.cursor)
+        case "backspace":
+            if len(b.Segments) > 1 {
+                return b, b.jump(len(b.Segments) - 2)
+            }
+        }
+    }
+    return b, nil
+}
+
+func (b BreadcrumbBar) View() string {
+    cursor := -1
+    if b.focused {
+        cursor = b.cursor
+    }
+    return renderCrumbs(b.Segments, b.Width, cursor, b.id)
+}
+
+// renderCrumbs draws segments, collapsing the middle to fit width. cursor
+// highlights one segment (-1 for none); a non-empty zonePrefix marks every
+// visible segment as a mouse zone.
+func renderCrumbs(segments []string, width, cursor int, zonePrefix string) string {
+    n := len(segments)
+    if n == 0 {
+        return ""
+    }
+
+    sepWidth := lipgloss.Width(crumbSeparator)
+    cost := func(i int) int { return lipgloss.Width(segments[i]) }
+
+    // Show every segment unless that overflows; then keep the root and walk
+    // back from the leaf while the ancestors still fit beside the ellipsis.
+    visible := make([]bool, n)
+    total := 0
+    for i := range segments {
+        visible[i] = true
+        total += cost(i)
+    }
+    total += sepWidth * (n - 1)
+
+    collapsed := width > 0 && total > width && n > 2
+    if collapsed {
+        for i := 1; i < n-1; i++ {
+            visible[i] = false
+        }
+        used := cost(0) + cost(n-1) + 2*sepWidth + 1 // Root, leaf and "…"
+        for i := n - 2; i > 0 && used+cost(i)+sepWidth <= width; i-- {
+            visible[i] = true
+            used += cost(i) + sepWidth
+        }
+    }
+
+    sep := lipgloss.NewStyle().Foreground(theme.Border).Render(crumbSeparator)
+    var parts []string
+    ellipsis := false
+    for i, seg := range segments {
+        if !visible[i] {
+            if !ellipsis {
+                parts = append(parts, theme.Caption.Render("…"))
+                ellipsis = true
+            }
+            continue
+        }
+
+        style := lipgloss.NewStyle().Foreground(theme.Subtext)
+        if i == n-1 {
+            style = lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+        }
+        if i == cursor {
+            style = style.Copy().Underline(true).Foreground(theme.Primary)
+        }
+        crumb := style.Render(seg)
+        if zonePrefix != "" {
+            crumb = zone.Mark(zonePrefix+":"+strconv.Itoa(i), crumb)
+        }
+        parts = append(parts, crumb)
+    }
+
+    out := strings.Join(parts, sep)
+    if width > 0 {
+        // Even root, ellipsis and leaf can be too wide; cut the leaf short
+        out = atoms.Truncate(out, width, "…")
+    }
+    return out
+}
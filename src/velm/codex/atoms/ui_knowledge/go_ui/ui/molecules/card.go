@@ -1,24 +1,126 @@
 package molecules
 
 import (
+    tea "github.com/charmbracelet/bubbletea"
     "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
     "gnostic-tui/ui/theme"
 )
 
+// CardOption customises a Card
+type CardOption func(*cardConfig)
+
+type cardConfig struct {
+    footer string
+}
+
+// CardFooter adds a region under a rule at the bottom of the card, typically CardActions.View()
+func CardFooter(footer string) CardOption {
+    return func(c *cardConfig) { c.footer = footer }
+}
+
 // Card renders a titled container
-func Card(title string, content string, width int) string {
-    titleRender := theme.TitleStyle.Render(title)
+func Card(title string, content string, width int, opts ...CardOption) string {
+    var cfg cardConfig
+    for _, opt := range opts {
+        opt(&cfg)
+    }
+
+    titleRender := theme.Derive(theme.H2.Copy().MarginBottom(theme.Space(1))).Render(title)
 
     // Ensure content wraps or fits
-    contentStyle := lipgloss.NewStyle().Width(width - 4) // Account for padding/border
+    inner := width - 2*theme.Space(2) // Account for padding
+    contentStyle := theme.Derive(lipgloss.NewStyle().Width(inner))
 
-    return theme.CardStyle.
+    rows := []string{titleRender, contentStyle.Render(content)}
+    if cfg.footer != "" {
+        rows = append(rows, "", atoms.HLine(inner), cfg.footer)
+    }
+
+    return theme.Derive(theme.CardStyle).
         Width(width).
-        Render(
-            lipgloss.JoinVertical(
-                lipgloss.Left,
-                titleRender,
-                contentStyle.Render(content),
-            ),
-        )
-}
\ No newline at end of file
+        Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// CardActions is a row of buttons for a card footer. While focused, left/right
+// (h/l) move focus between the buttons and enter or space presses one.
+type CardActions struct {
+    Buttons []atoms.Button
+    focus   int
+    focused bool
+}
+
+func NewCardActions(buttons ...atoms.Button) CardActions {
+    return CardActions{Buttons: buttons}
+}
+
+// Focus gives keyboard focus to the last focused button
+func (a *CardActions) Focus() {
+    a.focused = true
+    a.sync()
+}
+
+func (a *CardActions) Blur() {
+    a.focused = false
+    a.sync()
+}
+
+func (a CardActions) Focused() bool {
+    return a.focused
+}
+
+// sync makes exactly the focused button active
+func (a *CardActions) sync() {
+    a.focus = clamp(a.focus, 0, max(len(a.Buttons)-1, 0))
+    for i := range a.Buttons {
+        if a.focused && i == a.focus {
+            a.Buttons[i].Focus()
+        } else {
+            a.Buttons[i].Blur()
+        }
+    }
+}
+
+// move steps the focus, skipping disabled buttons and wrapping at the ends
+func (a *CardActions) move(delta int) {
+    for range a.Buttons {
+        a.focus = wrap(a.focus+delta, len(a.Buttons))
+        if !a.Buttons[a.focus].Disabled {
+            break
+        }
+    }
+    a.sync()
+}
+
+func (a CardActions) Update(msg tea.Msg) (CardActions, tea.Cmd) {
+    if len(a.Buttons) == 0 {
+        return a, nil
+    }
+    if key, ok := msg.(tea.KeyMsg); ok && a.focused {
+        switch key.String() {
+        case "left", "h":
+            a.move(-1)
+            return a, nil
+        case "right", "l":
+            a.move(1)
+            return a, nil
+        }
+    }
+
+    // Buttons share the rest: spinner ticks, clicks, and enter on the active one
+    buttons := make([]atoms.Button, len(a.Buttons))
+    cmds := make([]tea.Cmd, len(a.Buttons))
+    for i, b := range a.Buttons {
+        buttons[i], cmds[i] = b.Update(msg)
+    }
+    a.Buttons = buttons
+    return a, tea.Batch(cmds...)
+}
+
+func (a CardActions) View() string {
+    views := make([]string, len(a.Buttons))
+    for i, b := range a.Buttons {
+        views[i] = b.View()
+    }
+    return lipgloss.JoinHorizontal(lipgloss.Top, views...)
+}
@@ -0,0 +1,167 @@
+package molecules
+
+import (
+    "github.com/charmbracelet/bubbles/textinput"
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+// ConfirmResultMsg is emitted once a ConfirmPrompt is answered
+type ConfirmResultMsg struct {
+    ID        int
+    Confirmed bool
+}
+
+// ConfirmPrompt asks a yes/no question. left/right (h/l) or tab switch between
+// the buttons, enter answers with the highlighted one, y and n answer
+// directly and esc declines. In danger mode the keyword must be typed before
+// Yes unlocks, and y and n are ordinary letters for the keyword box.
+type ConfirmPrompt struct {
+    Question string
+    keyword  string
+    yes      atoms.Button
+    no       atoms.Button
+    input    textinput.Model
+    onYes    bool
+    answered bool
+    id       int
+}
+
+// Confirm creates a prompt defaulting to No
+func Confirm(question string) ConfirmPrompt {
+    c := ConfirmPrompt{
+        Question: question,
+        yes:      atoms.NewButton("Yes").WithVariant(atoms.ButtonPrimary),
+        no:       atoms.NewButton("No"),
+        id:       nextID(),
+    }
+    c.sync()
+    return c
+}
+
+// WithDefault returns a copy highlighting Yes when yes is true
+func (c ConfirmPrompt) WithDefault(yes bool) ConfirmPrompt {
+    c.onYes = yes
+    c.sync()
+    return c
+}
+
+// WithDanger returns a copy for a destructive action that is only allowed
+// after typing keyword, such as the name of the resource being deleted
+func (c ConfirmPrompt) WithDanger(keyword string) ConfirmPrompt {
+    c.keyword = keyword
+    c.yes = c.yes.WithVariant(atoms.ButtonDanger)
+    c.input = textinput.New()
+    c.input.Prompt = "› "
+    c.input.Placeholder = keyword
+    c.input.PromptStyle = lipgloss.NewStyle().Foreground(theme.Danger)
+    c.input.Focus()
+    c.onYes = false
+    c.sync()
+    return c
+}
+
+// ID identifies the prompt in ConfirmResultMsg
+func (c ConfirmPrompt) ID() int {
+    return c.id
+}
+
+func (c ConfirmPrompt) Answered() bool {
+    return c.answered
+}
+
+// unlocked reports whether Yes can be chosen
+func (c ConfirmPrompt) unlocked() bool {
+    return c.keyword == "" || c.input.Value() == c.keyword
+}
+
+// sync mirrors the highlight and lock onto the buttons
+func (c *ConfirmPrompt) sync() {
+    c.yes.Disabled = !c.unlocked()
+    if c.yes.Disabled {
+        c.onYes = false
+    }
+    c.yes.Active, c.no.Active = c.onYes, !c.onYes
+}
+
+func (c *ConfirmPrompt) answer(yes bool) tea.Cmd {
+    if yes && !c.unlocked() {
+        return nil
+    }
+    c.answered = true
+    msg := ConfirmResultMsg{ID: c.id, Confirmed: yes}
+    return func() tea.Msg { return msg }
+}
+
+func (c ConfirmPrompt) Update(msg tea.Msg) (ConfirmPrompt, tea.Cmd) {
+    if c.answered {
+        return c, nil
+    }
+
+    switch msg := msg.(type) {
+    case atoms.ButtonPressedMsg:
+        switch msg.ID {
+        case c.yes.ID():
+            return c, c.answer(true)
+        case c.no.ID():
+            return c, c.answer(false)
+        }
+    case tea.MouseMsg:
+        var cmd, cmd2 tea.Cmd
+        c.yes, cmd = c.yes.Update(msg)
+        c.no, cmd2 = c.no.Update(msg)
+        return c, tea.Batch(cmd, cmd2)
+    case tea.KeyMsg:
+        switch msg.String() {
+        case "left", "right", "tab", "shift+tab":
+            c.onYes = !c.onYes
+            c.sync()
+            return c, nil
+        case "enter":
+            return c, c.answer(c.onYes)
+        case "esc":
+            return c, c.answer(false)
+        }
+
+        if c.keyword != "" {
+            var cmd tea.Cmd
+            c.input, cmd = c.input.Update(msg)
+            c.onYes = c.unlocked() // Enter confirms as soon as the keyword matches
+            c.sync()
+            return c, cmd
+        }
+        switch msg.String() {
+        case "h":
+            c.onYes = true
+        case "l":
+            c.onYes = false
+        case "y", "Y":
+            return c, c.answer(true)
+        case "n", "N":
+            return c, c.answer(false)
+        }
+        c.sync()
+    }
+    return c, nil
+}
+
+func (c ConfirmPrompt) View() string {
+    rows := []string{theme.Derive(lipgloss.NewStyle()).Bold(true).Render(c.Question)}
+    if c.keyword != "" {
+        rows = append(rows,
+            theme.Caption.Render("Type ")+lipgloss.NewStyle().Foreground(theme.Danger).Bold(true).Render(c.keyword)+
+                theme.Caption.Render(" to confirm"),
+            c.input.View(),
+        )
+    }
+
+    hint := "y/n"
+    if c.keyword != "" {
+        hint = "enter confirm • esc cancel"
+    }
+    rows = append(rows, "",
+        lipgloss.JoinHorizontal(lipgloss.Center, c.yes.View(), c.no.View(), theme.Caption.Render(" "+hint)))
+    return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
@@ -0,0 +1,169 @@
+package molecules
+
+import (
+    "fmt"
+    "strings"
+    "time"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// DateSelectedMsg is emitted when a date is chosen with enter or space
+type DateSelectedMsg struct {
+    Date time.Time
+}
+
+// DatePicker is a month grid navigated with the arrow keys (or hjkl); pgup and
+// pgdown (or [ and ]) change month, t jumps to today. Days outside Min..Max are
+// dimmed and cannot be reached; a zero Min or Max leaves that side open.
+type DatePicker struct {
+    Min, Max time.Time
+    FirstDay time.Weekday
+    cursor   time.Time
+    selected time.Time
+    focused  bool
+}
+
+func NewDatePicker() DatePicker {
+    return DatePicker{FirstDay: time.Monday, cursor: dateOnly(time.Now())}
+}
+
+func (d *DatePicker) Focus() {
+    d.focused = true
+}
+
+func (d *DatePicker) Blur() {
+    d.focused = false
+}
+
+func (d DatePicker) Focused() bool {
+    return d.focused
+}
+
+// Selected returns the chosen date, or the zero time before one is chosen
+func (d DatePicker) Selected() time.Time {
+    return d.selected
+}
+
+// Cursor returns the highlighted date
+func (d DatePicker) Cursor() time.Time {
+    return d.cursor
+}
+
+// SetCursor moves the highlight to t, kept within Min..Max
+func (d *DatePicker) SetCursor(t time.Time) {
+    t = dateOnly(t)
+    if !d.Min.IsZero() && t.Before(dateOnly(d.Min)) {
+        t = dateOnly(d.Min)
+    }
+    if !d.Max.IsZero() && t.After(dateOnly(d.Max)) {
+        t = dateOnly(d.Max)
+    }
+    d.cursor = t
+}
+
+func (d DatePicker) inRange(t time.Time) bool {
+    return (d.Min.IsZero() || !t.Before(dateOnly(d.Min))) &&
+        (d.Max.IsZero() || !t.After(dateOnly(d.Max)))
+}
+
+func (d DatePicker) Update(msg tea.Msg) (DatePicker, tea.Cmd) {
+    if !d.focused {
+        return d, nil
+    }
+    if msg, ok := msg.(tea.KeyMsg); ok {
+        switch msg.String() {
+        case "left", "h":
+            d.SetCursor(d.cursor.AddDate(0, 0, -1))
+        case "right", "l":
+            d.SetCursor(d.cursor.AddDate(0, 0, 1))
+        case "up", "k":
+            d.SetCursor(d.cursor.AddDate(0, 0, -7))
+        case "down", "j":
+            d.SetCursor(d.cursor.AddDate(0, 0, 7))
+        case "pgup", "[":
+            d.SetCursor(addMonths(d.cursor, -1))
+        case "pgdown", "]":
+            d.SetCursor(addMonths(d.cursor, 1))
+        case "t":
+            d.SetCursor(time.Now())
+        case "enter", " ":
+            d.selected = d.cursor
+            date := d.cursor
+            return d, func() tea.Msg { return DateSelectedMsg{Date: date} }
+        }
+    }
+    return d, nil
+}
+
+func (d DatePicker) View() string {
+    const width = 7*3 - 1 // Seven two-digit columns with single gaps
+
+    arrow := lipgloss.NewStyle().Foreground(theme.Border)
+    if d.focused {
+        arrow = arrow.Copy().Foreground(theme.Primary)
+    }
+    title := d.cursor.Format("January 2006")
+    pad := width - 2 - len(title)
+    header := arrow.Render("‹") +
+        theme.Label.Render(strings.Repeat(" ", pad/2)+title+strings.Repeat(" ", pad-pad/2)) +
+        arrow.Render("›")
+
+    days := make([]string, 7)
+    for i := range days {
+        days[i] = time.Weekday((int(d.FirstDay) + i) % 7).String()[:2]
+    }
+
+    rows := []string{header, theme.Caption.Render(strings.Join(days, " "))}
+
+    today := dateOnly(time.Now())
+    first := time.Date(d.cursor.Year(), d.cursor.Month(), 1, 0, 0, 0, 0, d.cursor.Location())
+    lead := (int(first.Weekday()) - int(d.FirstDay) + 7) % 7
+
+    var week []string
+    for i := 0; i < lead; i++ {
+        week = append(week, "  ")
+    }
+    for day := first; day.Month() == first.Month(); day = day.AddDate(0, 0, 1) {
+        week = append(week, d.dayStyle(day, today).Render(fmt.Sprintf("%2d", day.Day())))
+        if len(week) == 7 {
+            rows = append(rows, strings.Join(week, " "))
+            week = nil
+        }
+    }
+    if len(week) > 0 {
+        rows = append(rows, strings.Join(week, " "))
+    }
+    return strings.Join(rows, "\n")
+}
+
+func (d DatePicker) dayStyle(day, today time.Time) lipgloss.Style {
+    style := theme.Derive(lipgloss.NewStyle())
+    switch {
+    case day.Equal(d.cursor) && d.focused:
+        return style.Background(theme.Primary).Foreground(lipgloss.Color("229")).Bold(true)
+    case day.Equal(d.selected):
+        return style.Background(theme.Accent).Foreground(lipgloss.Color("#000"))
+    case !d.inRange(day):
+        return style.Foreground(theme.Border)
+    case day.Equal(today):
+        return style.Foreground(theme.Accent).Bold(true).Underline(true)
+    }
+    return style
+}
+
+// dateOnly drops the clock part of t, keeping its location
+func dateOnly(t time.Time) time.Time {
+    y, m, d := t.Date()
+    return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// addMonths moves by whole months, pinning the day to the end of shorter months
+// (Jan 31 + 1 month is Feb 28, not Mar 3)
+func addMonths(t time.Time, n int) time.Time {
+    first := time.Date(t.Year(), t.Month()+time.Month(n), 1, 0, 0, 0, 0, t.Location())
+    last := first.AddDate(0, 1, -1).Day()
+    return first.AddDate(0, 0, min(t.Day(), last)-1)
+}
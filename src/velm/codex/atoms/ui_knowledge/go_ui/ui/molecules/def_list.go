@@ -0,0 +1,53 @@
+package molecules
+
+import (
+    "strings"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+// DefListOption customises a DefList
+type DefListOption func(*defListConfig)
+
+type defListConfig struct {
+    width int
+}
+
+// DefListWidth sets the total width that definitions wrap within
+func DefListWidth(w int) DefListOption {
+    return func(c *defListConfig) { c.width = w }
+}
+
+// DefList renders "describe"-style term/definition rows. The term column is as
+// wide as the longest term, up to a third of the width, and longer terms are
+// truncated; definitions wrap with their continuation lines aligned.
+func DefList(pairs []KVPair, opts ...DefListOption) string {
+    cfg := defListConfig{width: 60}
+    for _, opt := range opts {
+        opt(&cfg)
+    }
+
+    keyWidth := 0
+    for _, p := range pairs {
+        keyWidth = max(keyWidth, lipgloss.Width(p.Key))
+    }
+    keyWidth = min(keyWidth, max(cfg.width/3, 1))
+
+    gap := strings.Repeat(" ", theme.Space(2))
+    valueWidth := max(cfg.width-keyWidth-len(gap), 1)
+
+    term := theme.Label.Copy().Width(keyWidth)
+    def := theme.Derive(lipgloss.NewStyle().Width(valueWidth))
+
+    rows := make([]string, len(pairs))
+    for i, p := range pairs {
+        rows[i] = lipgloss.JoinHorizontal(lipgloss.Top,
+            term.Render(atoms.Truncate(p.Key, keyWidth, "…")),
+            gap,
+            def.Render(p.Value),
+        )
+    }
+    return strings.Join(rows, "\n")
+}
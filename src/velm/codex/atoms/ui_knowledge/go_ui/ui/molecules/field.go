@@ -0,0 +1,57 @@
+package molecules
+
+import (
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+// FieldOption customises a Field
+type FieldOption func(*fieldConfig)
+
+type fieldConfig struct {
+    required bool
+    help     string
+    err      error
+}
+
+// FieldRequired marks the label with a red asterisk
+func FieldRequired() FieldOption {
+    return func(c *fieldConfig) { c.required = true }
+}
+
+// FieldHelp sets the hint shown under the input
+func FieldHelp(text string) FieldOption {
+    return func(c *fieldConfig) { c.help = text }
+}
+
+// FieldError shows err under the input in place of the help text; nil clears it
+func FieldError(err error) FieldOption {
+    return func(c *fieldConfig) { c.err = err }
+}
+
+// Field lays out a form row: label above, the rendered input, then help or error below.
+// input is any already rendered control, e.g. a textinput's View().
+func Field(label string, input string, opts ...FieldOption) string {
+    var cfg fieldConfig
+    for _, opt := range opts {
+        opt(&cfg)
+    }
+
+    head := theme.Label.Render(label)
+    if cfg.required {
+        head += lipgloss.NewStyle().Foreground(theme.Danger).Bold(true).Render(" *")
+    }
+
+    rows := []string{head, input}
+    switch {
+    case cfg.err != nil:
+        rows = append(rows, lipgloss.NewStyle().Foreground(theme.Danger).
+            Render(atoms.Icon("cross")+" "+cfg.err.Error()))
+    case cfg.help != "":
+        rows = append(rows, theme.Caption.Render(cfg.help))
+    }
+
+    return theme.Derive(lipgloss.NewStyle().MarginBottom(theme.Space(1))).
+        Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
@@ -0,0 +1,10 @@
+package molecules
+
+import "sync/atomic"
+
+var lastID int64
+
+// nextID hands out unique ids so delayed messages reach only the component that scheduled them
+func nextID() int {
+    return int(atomic.AddInt64(&lastID, 1))
+}
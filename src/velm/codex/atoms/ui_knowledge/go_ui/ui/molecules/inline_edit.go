@@ -0,0 +1,142 @@
+package molecules
+
+import (
+    "github.com/charmbracelet/bubbles/textinput"
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
+)
+
+// EditCommittedMsg is emitted when an InlineEdit's new value is accepted
+type EditCommittedMsg struct {
+    ID       string
+    Old, New string
+}
+
+// EditCancelledMsg is emitted when editing is abandoned with esc
+type EditCancelledMsg struct {
+    ID string
+}
+
+// InlineEdit shows a value as plain text until enter (or a click) turns it
+// into a text input in place. While editing, enter commits and esc cancels;
+// blurring drops the edit without a message.
+type InlineEdit struct {
+    Width   int
+    value   string
+    input   textinput.Model
+    editing bool
+    focused bool
+    id      string
+}
+
+func NewInlineEdit(value string) InlineEdit {
+    in := textinput.New()
+    in.Prompt = ""
+    in.TextStyle = lipgloss.NewStyle().Foreground(theme.Text)
+    return InlineEdit{Width: 20, value: value, input: in, id: zone.NewID("inline")}
+}
+
+// ID identifies the editor in its messages
+func (e InlineEdit) ID() string {
+    return e.id
+}
+
+func (e InlineEdit) Value() string {
+    return e.value
+}
+
+func (e *InlineEdit) SetValue(v string) {
+    e.value = v
+}
+
+func (e InlineEdit) Editing() bool {
+    return e.editing
+}
+
+func (e *InlineEdit) Focus() {
+    e.focused = true
+}
+
+// Blur leaves edit mode, discarding the typed text
+func (e *InlineEdit) Blur() {
+    e.focused = false
+    e.editing = false
+    e.input.Blur()
+}
+
+func (e InlineEdit) Focused() bool {
+    return e.focused
+}
+
+// Edit swaps in the text input, prefilled with the value
+func (e *InlineEdit) Edit() tea.Cmd {
+    e.editing = true
+    e.input.SetValue(e.value)
+    e.input.CursorEnd()
+    return e.input.Focus()
+}
+
+func (e *InlineEdit) finish(commit bool) tea.Cmd {
+    e.editing = false
+    e.input.Blur()
+    if !commit {
+        msg := EditCancelledMsg{ID: e.id}
+        return func() tea.Msg { return msg }
+    }
+    msg := EditCommittedMsg{ID: e.id, Old: e.value, New: e.input.Value()}
+    e.value = msg.New
+    return func() tea.Msg { return msg }
+}
+
+func (e InlineEdit) Update(msg tea.Msg) (InlineEdit, tea.Cmd) {
+    if mouse, ok := msg.(tea.MouseMsg); ok {
+        if !e.editing && zone.Get(e.id).Clicked(mouse) {
+            e.focused = true
+            return e, e.Edit()
+        }
+        return e, nil
+    }
+    if !e.focused {
+        return e, nil
+    }
+
+    key, isKey := msg.(tea.KeyMsg)
+    if !e.editing {
+        if isKey && key.String() == "enter" {
+            return e, e.Edit()
+        }
+        return e, nil
+    }
+
+    if isKey {
+        switch key.String() {
+        case "enter":
+            return e, e.finish(true)
+        case "esc":
+            return e, e.finish(false)
+        }
+    }
+    var cmd tea.Cmd
+    e.input, cmd = e.input.Update(msg)
+    return e, cmd
+}
+
+func (e InlineEdit) View() string {
+    if e.editing {
+        e.input.Width = max(e.Width-1, 1)
+        return zone.Mark(e.id, lipgloss.NewStyle().Width(e.Width).Underline(true).Render(e.input.View()))
+    }
+
+    style := theme.Derive(lipgloss.NewStyle().Width(e.Width))
+    if e.focused {
+        style = style.Copy().Foreground(theme.Primary).Underline(true)
+    }
+    text := e.value
+    if text == "" {
+        text = "—"
+    }
+    return zone.Mark(e.id, style.Render(atoms.Truncate(text, e.Width, "…")))
+}
@@ -2,12 +2,13 @@ package molecules
 
 import (
     "github.com/charmbracelet/bubbles/textinput"
+    tea "github.com/charmbracelet/bubbletea"
     "github.com/charmbracelet/lipgloss"
     "gnostic-tui/ui/theme"
 )
 
-// NewSearchInput creates a styled text input for searching
-func NewSearchInput() textinput.Model {
+// searchTextInput creates the styled text input behind SearchInput and Autocomplete
+func searchTextInput() textinput.Model {
     ti := textinput.New()
     ti.Placeholder = "Search the cosmos..."
     ti.CharLimit = 156
@@ -19,4 +20,65 @@ func NewSearchInput() textinput.Model {
     ti.PlaceholderStyle = lipgloss.NewStyle().Foreground(theme.Subtext)
 
     return ti
-}
\ No newline at end of file
+}
+
+// ValidationFailedMsg is emitted when an edit leaves a ValidatedInput invalid
+type ValidationFailedMsg struct {
+    Name string
+    Err  error
+}
+
+// ValidatedInput re-checks a text input after every edit. The failure is kept in
+// the embedded model's Err and the prompt turns red until the value passes.
+//
+// textinput's own Validate hook is left unset: it refuses edits that fail, which
+// would make Required or MinLength impossible to type towards.
+type ValidatedInput struct {
+    textinput.Model
+    Name       string // Reported in ValidationFailedMsg
+    Validators []Validator
+    prompt     lipgloss.Style
+}
+
+// NewValidatedInput wraps an input, e.g. NewSearchInput().Model, with validators
+func NewValidatedInput(ti textinput.Model, name string, validators ...Validator) ValidatedInput {
+    return ValidatedInput{Model: ti, Name: name, Validators: validators, prompt: ti.PromptStyle}
+}
+
+// Valid reports whether the current value passed its last check
+func (v ValidatedInput) Valid() bool {
+    return v.Err == nil
+}
+
+// Validate checks the current value now, e.g. before submitting a form
+func (v *ValidatedInput) Validate() tea.Cmd {
+    if v.check() == nil {
+        return nil
+    }
+    msg := ValidationFailedMsg{Name: v.Name, Err: v.Err}
+    return func() tea.Msg { return msg }
+}
+
+// check refreshes Err and the prompt color without reporting the result
+func (v *ValidatedInput) check() error {
+    v.Err = Validate(v.Value(), v.Validators...)
+    v.PromptStyle = v.prompt
+    if v.Err != nil {
+        v.PromptStyle = v.prompt.Copy().Foreground(theme.Danger)
+    }
+    return v.Err
+}
+
+func (v ValidatedInput) Update(msg tea.Msg) (ValidatedInput, tea.Cmd) {
+    before := v.Value()
+
+    var cmd tea.Cmd
+    v.Model, cmd = v.Model.Update(msg)
+    if v.Value() == before {
+        // textinput clears Err on some keys even when nothing changed
+        v.check()
+        return v, cmd
+    }
+    vcmd := v.Validate()
+    return v, tea.Batch(cmd, vcmd)
+}
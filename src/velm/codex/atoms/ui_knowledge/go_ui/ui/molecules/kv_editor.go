@@ -0,0 +1,244 @@
+package molecules
+
+import (
+    "fmt"
+    "sort"
+    "strings"
+
+    "github.com/charmbracelet/bubbles/textinput"
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+// KVPair is one row of a KVEditor
+type KVPair struct {
+    Key, Value string
+}
+
+// KVEditor edits a list of string pairs such as environment variables or labels.
+// up/down (k/j) pick a row and left/right or tab pick the key or value cell;
+// enter edits the cell, a adds a row, d removes one. While editing, enter or
+// tab commits and esc cancels. Repeated keys are flagged and make Err non-nil.
+type KVEditor struct {
+    KeyWidth   int
+    ValueWidth int
+    pairs      []KVPair
+    row, col   int
+    editing    bool
+    input      textinput.Model
+    focused    bool
+}
+
+// NewKVEditor starts from m with its keys sorted
+func NewKVEditor(m map[string]string) KVEditor {
+    keys := make([]string, 0, len(m))
+    for k := range m {
+        keys = append(keys, k)
+    }
+    sort.Strings(keys)
+
+    pairs := make([]KVPair, len(keys))
+    for i, k := range keys {
+        pairs[i] = KVPair{k, m[k]}
+    }
+
+    in := textinput.New()
+    in.Prompt = ""
+    in.TextStyle = lipgloss.NewStyle().Foreground(theme.Text)
+    return KVEditor{KeyWidth: 20, ValueWidth: 30, pairs: pairs, input: in}
+}
+
+func (e *KVEditor) Focus() {
+    e.focused = true
+}
+
+// Blur commits a cell being edited
+func (e *KVEditor) Blur() {
+    if e.editing {
+        e.commit()
+    }
+    e.focused = false
+}
+
+func (e KVEditor) Focused() bool {
+    return e.focused
+}
+
+// Editing reports whether a cell has the text cursor, so hosts can hold back
+// their own single-letter bindings
+func (e KVEditor) Editing() bool {
+    return e.editing
+}
+
+// Pairs returns the rows in display order, duplicates included
+func (e KVEditor) Pairs() []KVPair {
+    return append([]KVPair(nil), e.pairs...)
+}
+
+// Map returns the rows as a map; for repeated keys the last row wins
+func (e KVEditor) Map() map[string]string {
+    m := make(map[string]string, len(e.pairs))
+    for _, p := range e.pairs {
+        m[p.Key] = p.Value
+    }
+    return m
+}
+
+// Err reports the first empty or repeated key, or nil when the rows are usable
+func (e KVEditor) Err() error {
+    seen := map[string]bool{}
+    for i, p := range e.pairs {
+        switch {
+        case strings.TrimSpace(p.Key) == "":
+            return fmt.Errorf("row %d: empty key", i+1)
+        case seen[p.Key]:
+            return fmt.Errorf("duplicate key %q", p.Key)
+        }
+        seen[p.Key] = true
+    }
+    return nil
+}
+
+// duplicates counts how often each key occurs
+func (e KVEditor) duplicates() map[string]int {
+    counts := map[string]int{}
+    for _, p := range e.pairs {
+        counts[p.Key]++
+    }
+    return counts
+}
+
+func (e *KVEditor) cell() *string {
+    if e.col == 0 {
+        return &e.pairs[e.row].Key
+    }
+    return &e.pairs[e.row].Value
+}
+
+func (e *KVEditor) edit() tea.Cmd {
+    e.editing = true
+    e.input.SetValue(*e.cell())
+    e.input.CursorEnd()
+    return e.input.Focus()
+}
+
+func (e *KVEditor) commit() {
+    *e.cell() = e.input.Value()
+    e.editing = false
+    e.input.Blur()
+}
+
+func (e *KVEditor) cancel() {
+    e.editing = false
+    e.input.Blur()
+}
+
+func (e *KVEditor) Add() tea.Cmd {
+    e.pairs = append(e.pairs, KVPair{})
+    e.row, e.col = len(e.pairs)-1, 0
+    return e.edit()
+}
+
+func (e *KVEditor) Remove(i int) {
+    if i < 0 || i >= len(e.pairs) {
+        return
+    }
+    e.pairs = append(e.pairs[:i:i], e.pairs[i+1:]...)
+    e.row = clamp(e.row, 0, max(len(e.pairs)-1, 0))
+}
+
+func (e KVEditor) Update(msg tea.Msg) (KVEditor, tea.Cmd) {
+    if !e.focused {
+        return e, nil
+    }
+
+    if e.editing {
+        if key, ok := msg.(tea.KeyMsg); ok {
+            switch key.String() {
+            case "enter":
+                e.commit()
+                return e, nil
+            case "tab":
+                e.commit()
+                if e.col == 0 {
+                    e.col = 1
+                    return e, e.edit()
+                }
+                return e, nil
+            case "esc":
+                e.cancel()
+                return e, nil
+            }
+        }
+        var cmd tea.Cmd
+        e.input, cmd = e.input.Update(msg)
+        return e, cmd
+    }
+
+    if key, ok := msg.(tea.KeyMsg); ok {
+        switch key.String() {
+        case "up", "k":
+            e.row = max(e.row-1, 0)
+        case "down", "j":
+            e.row = clamp(e.row+1, 0, max(len(e.pairs)-1, 0))
+        case "left", "h":
+            e.col = 0
+        case "right", "l":
+            e.col = 1
+        case "tab":
+            e.col = 1 - e.col
+        case "a":
+            return e, e.Add()
+        case "d", "delete":
+            e.Remove(e.row)
+        case "enter", "e":
+            if len(e.pairs) > 0 {
+                return e, e.edit()
+            }
+        }
+    }
+    return e, nil
+}
+
+func (e KVEditor) View() string {
+    header := theme.Label.Copy().Width(e.KeyWidth).Render("KEY") + " " +
+        theme.Label.Copy().Width(e.ValueWidth).Render("VALUE")
+    rows := []string{header}
+
+    counts := e.duplicates()
+    for i, p := range e.pairs {
+        cells := []string{p.Key, p.Value}
+        widths := []int{e.KeyWidth, e.ValueWidth}
+        for c := range cells {
+            style := theme.Derive(lipgloss.NewStyle().Width(widths[c]))
+            if c == 0 && (counts[p.Key] > 1 || strings.TrimSpace(p.Key) == "") {
+                style = style.Copy().Foreground(theme.Danger)
+            }
+            if e.focused && i == e.row && c == e.col {
+                if e.editing {
+                    e.input.Width = widths[c] - 1
+                    cells[c] = style.Copy().Underline(true).Render(e.input.View())
+                    continue
+                }
+                style = style.Copy().Background(theme.Primary).Foreground(lipgloss.Color("229"))
+            }
+            cells[c] = style.Render(atoms.Truncate(cells[c], widths[c], "…"))
+        }
+
+        line := cells[0] + " " + cells[1]
+        if counts[p.Key] > 1 {
+            line += lipgloss.NewStyle().Foreground(theme.Danger).Render(" " + atoms.Icon("warning") + " duplicate")
+        }
+        rows = append(rows, line)
+    }
+
+    if len(e.pairs) == 0 {
+        rows = append(rows, theme.Caption.Render("No entries"))
+    }
+    if e.focused {
+        rows = append(rows, theme.Caption.Render("a add • d delete • enter edit"))
+    }
+    return strings.Join(rows, "\n")
+}
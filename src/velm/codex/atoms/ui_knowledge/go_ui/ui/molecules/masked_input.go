@@ -0,0 +1,219 @@
+package molecules
+
+import (
+    "strings"
+    "unicode"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// Mask placeholders; every other rune in a mask is a literal
+const (
+    maskDigit     = '#' // 0-9
+    maskLetter    = 'A' // Any letter
+    maskAlnum     = '*' // Letter or digit
+    maskEmptyRune = '_'
+)
+
+// MaskedField is an input constrained by a mask such as "###-###" or
+// "####-##-##". The cursor only stops on placeholders, stepping over the
+// literals between them, and runes that do not fit the placeholder are ignored.
+type MaskedField struct {
+    mask    []rune
+    slots   []rune // Typed rune per mask position, 0 while empty or literal
+    pos     int    // Mask index of the cursor, always a placeholder
+    focused bool
+}
+
+// MaskedInput creates a field for mask
+func MaskedInput(mask string) MaskedField {
+    m := MaskedField{mask: []rune(mask)}
+    m.slots = make([]rune, len(m.mask))
+    m.pos = m.next(-1)
+    return m
+}
+
+func isPlaceholder(r rune) bool {
+    return r == maskDigit || r == maskLetter || r == maskAlnum
+}
+
+func fits(placeholder, r rune) bool {
+    switch placeholder {
+    case maskDigit:
+        return unicode.IsDigit(r)
+    case maskLetter:
+        return unicode.IsLetter(r)
+    default:
+        return unicode.IsLetter(r) || unicode.IsDigit(r)
+    }
+}
+
+// next returns the first placeholder after i, or len(mask) past the last one
+func (m MaskedField) next(i int) int {
+    for i++; i < len(m.mask); i++ {
+        if isPlaceholder(m.mask[i]) {
+            return i
+        }
+    }
+    return len(m.mask)
+}
+
+// prev returns the last placeholder before i, or -1
+func (m MaskedField) prev(i int) int {
+    for i--; i >= 0; i-- {
+        if isPlaceholder(m.mask[i]) {
+            return i
+        }
+    }
+    return -1
+}
+
+func (m *MaskedField) Focus() {
+    m.focused = true
+}
+
+func (m *MaskedField) Blur() {
+    m.focused = false
+}
+
+func (m MaskedField) Focused() bool {
+    return m.focused
+}
+
+// Value returns the text with literals in place and "_" for empty placeholders
+func (m MaskedField) Value() string {
+    var b strings.Builder
+    for i, r := range m.mask {
+        switch {
+        case !isPlaceholder(r):
+            b.WriteRune(r)
+        case m.slots[i] != 0:
+            b.WriteRune(m.slots[i])
+        default:
+            b.WriteRune(maskEmptyRune)
+        }
+    }
+    return b.String()
+}
+
+// Raw returns only the typed runes, without literals
+func (m MaskedField) Raw() string {
+    var b strings.Builder
+    for _, r := range m.slots {
+        if r != 0 {
+            b.WriteRune(r)
+        }
+    }
+    return b.String()
+}
+
+// Complete reports whether every placeholder is filled
+func (m MaskedField) Complete() bool {
+    for i, r := range m.mask {
+        if isPlaceholder(r) && m.slots[i] == 0 {
+            return false
+        }
+    }
+    return true
+}
+
+// SetValue types s into the field from the start, as if entered by hand
+func (m *MaskedField) SetValue(s string) {
+    m.slots = make([]rune, len(m.mask))
+    m.pos = m.next(-1)
+    for _, r := range s {
+        m.insert(r)
+    }
+}
+
+func (m *MaskedField) insert(r rune) {
+    if m.pos >= len(m.mask) {
+        return
+    }
+    // Typing the literal that follows the cursor just steps past it
+    if lit := m.pos - 1; lit >= 0 && !isPlaceholder(m.mask[lit]) && m.mask[lit] == r && m.slots[m.pos] == 0 {
+        return
+    }
+    if !fits(m.mask[m.pos], r) {
+        return
+    }
+    m.slots[m.pos] = r
+    m.pos = m.next(m.pos)
+}
+
+func (m MaskedField) Update(msg tea.Msg) (MaskedField, tea.Cmd) {
+    if !m.focused {
+        return m, nil
+    }
+    if key, ok := msg.(tea.KeyMsg); ok {
+        switch key.String() {
+        case "left":
+            if p := m.prev(m.pos); p >= 0 {
+                m.pos = p
+            }
+        case "right":
+            if m.pos < len(m.mask) {
+                m.pos = m.next(m.pos)
+            }
+        case "home", "ctrl+a":
+            m.pos = m.next(-1)
+        case "end", "ctrl+e":
+            m.pos = m.prev(len(m.mask))
+            if m.pos >= 0 && m.slots[m.pos] != 0 {
+                m.pos = m.next(m.pos)
+            }
+        case "backspace":
+            if p := m.prev(m.pos); p >= 0 {
+                m.pos = p
+                m.slots[p] = 0
+            }
+        case "delete":
+            if m.pos < len(m.mask) {
+                m.slots[m.pos] = 0
+            }
+        default:
+            if key.Type == tea.KeyRunes {
+                for _, r := range key.Runes {
+                    m.insert(r)
+                }
+            }
+        }
+    }
+    return m, nil
+}
+
+func (m MaskedField) View() string {
+    literal := lipgloss.NewStyle().Foreground(theme.Subtext)
+    empty := lipgloss.NewStyle().Foreground(theme.Border)
+    filled := theme.Derive(lipgloss.NewStyle())
+    cursor := lipgloss.NewStyle().Reverse(true)
+
+    var b strings.Builder
+    for i, r := range m.mask {
+        var cell string
+        switch {
+        case !isPlaceholder(r):
+            cell = literal.Render(string(r))
+        case m.slots[i] != 0:
+            cell = string(m.slots[i])
+            if m.focused && i == m.pos {
+                cell = cursor.Render(cell)
+            } else {
+                cell = filled.Render(cell)
+            }
+        default:
+            if m.focused && i == m.pos {
+                cell = cursor.Render(string(maskEmptyRune))
+            } else {
+                cell = empty.Render(string(maskEmptyRune))
+            }
+        }
+        b.WriteString(cell)
+    }
+    if m.focused && m.pos >= len(m.mask) {
+        b.WriteString(cursor.Render(" "))
+    }
+    return b.String()
+}
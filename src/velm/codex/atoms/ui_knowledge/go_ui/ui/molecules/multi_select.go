@@ -0,0 +1,223 @@
+package molecules
+
+import (
+    "strconv"
+    "strings"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
+)
+
+// MultiSelect is a collapsed field summarising a set of checked options
+// ("3 selected"). Enter opens the checklist: space toggles the option under
+// the cursor, a checks all, n clears all, enter or esc closes it again. Like
+// Select, the open list is drawn by Overlay.
+type MultiSelect struct {
+    Options []string
+    Width   int
+    checked []bool
+    open    bool
+    cursor  int
+    focused bool
+    id      string
+}
+
+func NewMultiSelect(options ...string) MultiSelect {
+    return MultiSelect{
+        Options: options,
+        Width:   24,
+        checked: make([]bool, len(options)),
+        id:      zone.NewID("multiselect"),
+    }
+}
+
+func (m *MultiSelect) Focus() {
+    m.focused = true
+}
+
+// Blur also closes the checklist
+func (m *MultiSelect) Blur() {
+    m.focused = false
+    m.open = false
+}
+
+func (m MultiSelect) Focused() bool {
+    return m.focused
+}
+
+func (m MultiSelect) IsOpen() bool {
+    return m.open
+}
+
+// Selected returns the indexes of the checked options in order
+func (m MultiSelect) Selected() []int {
+    var out []int
+    for i, on := range m.checked {
+        if on {
+            out = append(out, i)
+        }
+    }
+    return out
+}
+
+// Values returns the checked options in order
+func (m MultiSelect) Values() []string {
+    var out []string
+    for _, i := range m.Selected() {
+        out = append(out, m.Options[i])
+    }
+    return out
+}
+
+func (m *MultiSelect) SetChecked(i int, on bool) {
+    m.sync()
+    if i >= 0 && i < len(m.checked) {
+        m.checked[i] = on
+    }
+}
+
+func (m *MultiSelect) SelectAll() {
+    m.setAll(true)
+}
+
+func (m *MultiSelect) SelectNone() {
+    m.setAll(false)
+}
+
+func (m *MultiSelect) setAll(on bool) {
+    m.sync()
+    for i := range m.checked {
+        m.checked[i] = on
+    }
+}
+
+// sync resizes the checked set after Options was replaced
+func (m *MultiSelect) sync() {
+    if len(m.checked) != len(m.Options) {
+        checked := make([]bool, len(m.Options))
+        copy(checked, m.checked)
+        m.checked = checked
+    }
+}
+
+func (m MultiSelect) Update(msg tea.Msg) (MultiSelect, tea.Cmd) {
+    m.sync()
+    switch msg := msg.(type) {
+    case tea.MouseMsg:
+        if zone.Get(m.id).Clicked(msg) {
+            m.open = !m.open
+        }
+    case tea.KeyMsg:
+        if !m.focused {
+            return m, nil
+        }
+        if !m.open {
+            switch msg.String() {
+            case "enter", " ", "down":
+                m.open = true
+            }
+            return m, nil
+        }
+
+        switch msg.String() {
+        case "up", "k":
+            if m.cursor > 0 {
+                m.cursor--
+            }
+        case "down", "j":
+            if m.cursor < len(m.Options)-1 {
+                m.cursor++
+            }
+        case " ", "x":
+            if m.cursor < len(m.checked) {
+                m.checked[m.cursor] = !m.checked[m.cursor]
+            }
+        case "a":
+            m.SelectAll()
+        case "n":
+            m.SelectNone()
+        case "enter", "esc":
+            m.open = false
+        }
+    }
+    return m, nil
+}
+
+// Summary is the collapsed text: "None", the single option, or "3 selected"
+func (m MultiSelect) Summary() string {
+    switch values := m.Values(); len(values) {
+    case 0:
+        return "None"
+    case 1:
+        return values[0]
+    case len(m.Options):
+        return "All (" + strconv.Itoa(len(values)) + ")"
+    default:
+        return strconv.Itoa(len(values)) + " selected"
+    }
+}
+
+func (m MultiSelect) View() string {
+    chevron := "▾"
+    if m.open {
+        chevron = "▴"
+    }
+
+    border := theme.Border
+    if m.focused {
+        border = theme.Primary
+    }
+
+    inner := max(m.Width-4, 1) // Border and the chevron column
+    summary := theme.Derive(lipgloss.NewStyle().Width(inner))
+    if len(m.Selected()) == 0 {
+        summary = summary.Copy().Foreground(theme.Subtext)
+    }
+    field := lipgloss.NewStyle().
+        Border(lipgloss.RoundedBorder()).
+        BorderForeground(border).
+        Render(summary.Render(atoms.Truncate(m.Summary(), inner, "…")) + " " +
+            lipgloss.NewStyle().Foreground(border).Render(chevron))
+
+    return zone.Mark(m.id, field)
+}
+
+// Menu renders the open checklist, or "" while closed
+func (m MultiSelect) Menu() string {
+    if !m.open {
+        return ""
+    }
+
+    inner := max(m.Width-2, 1)
+    rows := []string{theme.Caption.Render(atoms.Truncate("a all • n none • space toggle", inner, "…"))}
+
+    start := clamp(m.cursor-selectMaxRows+1, 0, max(len(m.Options)-selectMaxRows, 0))
+    end := min(start+selectMaxRows, len(m.Options))
+    for i := start; i < end; i++ {
+        box := atoms.NewCheckbox(atoms.Truncate(m.Options[i], inner-4, "…"))
+        if m.checked[i] {
+            box.State = atoms.Checked
+        }
+        if i == m.cursor {
+            box.Focus()
+        }
+        rows = append(rows, lipgloss.NewStyle().Width(inner).Render(box.View()))
+    }
+
+    return lipgloss.NewStyle().
+        Border(lipgloss.RoundedBorder()).
+        BorderForeground(theme.Primary).
+        Render(strings.Join(rows, "\n"))
+}
+
+// Overlay draws the open checklist below the field in a scanned frame
+func (m MultiSelect) Overlay(screen string) string {
+    z := zone.Get(m.id)
+    if !m.open || z.IsZero() {
+        return screen
+    }
+    return atoms.Overlay(screen, m.Menu(), z.StartX, z.EndY+1)
+}
@@ -0,0 +1,146 @@
+package molecules
+
+import (
+    "math"
+    "strconv"
+    "strings"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// NumberInput is a numeric field stepped with the arrow keys or +/-. Digits can
+// also be typed directly; enter (or blur) commits them and esc discards them.
+// When Min is negative, - before any digits is the sign rather than a step.
+// Digits that do not read as a number stay up, marked invalid, until fixed.
+type NumberInput struct {
+    Min, Max float64
+    Step     float64
+    value    float64
+    draft    string // Digits typed but not yet committed
+    invalid  bool   // The draft failed to commit
+    focused  bool
+}
+
+func NewNumberInput(lo, hi, step float64) NumberInput {
+    n := NumberInput{Min: lo, Max: hi, Step: step}
+    n.SetValue(0)
+    return n
+}
+
+func (n *NumberInput) Focus() {
+    n.focused = true
+}
+
+// Blur commits any typed digits
+func (n *NumberInput) Blur() {
+    n.commit()
+    n.focused = false
+}
+
+func (n NumberInput) Focused() bool {
+    return n.focused
+}
+
+func (n NumberInput) Value() float64 {
+    return n.value
+}
+
+// SetValue clamps v into Min..Max
+func (n *NumberInput) SetValue(v float64) {
+    // Round off float drift so stepping 0.1 ten times lands on 1
+    v = math.Round(v*1e9) / 1e9
+    n.value = math.Max(n.Min, math.Min(n.Max, v))
+}
+
+// Invalid reports whether typed digits failed to read as a number
+func (n NumberInput) Invalid() bool {
+    return n.invalid
+}
+
+func (n *NumberInput) Increment() {
+    if n.commit() {
+        n.SetValue(n.value + n.Step)
+    }
+}
+
+func (n *NumberInput) Decrement() {
+    if n.commit() {
+        n.SetValue(n.value - n.Step)
+    }
+}
+
+// commit applies the typed digits, reporting false and keeping them when
+// they are not a number
+func (n *NumberInput) commit() bool {
+    if n.draft == "" {
+        return true
+    }
+    v, err := strconv.ParseFloat(n.draft, 64)
+    if n.invalid = err != nil; n.invalid {
+        return false
+    }
+    n.SetValue(v)
+    n.draft = ""
+    return true
+}
+
+func (n NumberInput) Update(msg tea.Msg) (NumberInput, tea.Cmd) {
+    if !n.focused {
+        return n, nil
+    }
+    if msg, ok := msg.(tea.KeyMsg); ok {
+        switch key := msg.String(); key {
+        case "up", "k", "+":
+            n.Increment()
+        case "down", "j", "-":
+            if key == "-" && n.draft == "" && n.Min < 0 {
+                n.draft = key
+            } else {
+                n.Decrement()
+            }
+        case "enter":
+            n.commit()
+        case "esc":
+            n.draft, n.invalid = "", false
+        case "backspace":
+            if n.draft != "" {
+                n.draft, n.invalid = n.draft[:len(n.draft)-1], false
+            }
+        default:
+            digit := len(key) == 1 && key[0] >= '0' && key[0] <= '9'
+            point := key == "." && !strings.Contains(n.draft, ".")
+            if digit || point {
+                n.draft, n.invalid = n.draft+key, false
+            }
+        }
+    }
+    return n, nil
+}
+
+func (n NumberInput) View() string {
+    text := strconv.FormatFloat(n.value, 'f', -1, 64)
+    value := theme.Derive(lipgloss.NewStyle())
+    if n.draft != "" {
+        text = n.draft
+        value = value.Copy().Underline(true)
+    }
+    if n.invalid {
+        value = value.Copy().Foreground(theme.Danger)
+    }
+
+    arrows := lipgloss.NewStyle().Foreground(theme.Border)
+    if n.focused {
+        arrows = arrows.Copy().Foreground(theme.Primary)
+        value = value.Copy().Bold(true)
+    }
+    down, up := "▾", "▴"
+    if n.value <= n.Min && n.draft == "" {
+        down = " "
+    }
+    if n.value >= n.Max && n.draft == "" {
+        up = " "
+    }
+    return arrows.Render(down) + " " + value.Render(text) + " " + arrows.Render(up)
+}
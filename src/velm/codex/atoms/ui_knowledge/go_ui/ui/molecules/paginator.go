@@ -0,0 +1,133 @@
+package molecules
+
+import (
+    "strconv"
+    "strings"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// PaginatorKind picks how the page position is drawn
+type PaginatorKind int
+
+const (
+    PagesNumeric PaginatorKind = iota // ← 3/12 →
+    PagesDots                         // ○ ○ ● ○
+)
+
+// paginatorMaxDots is the page count above which dots fall back to numbers
+const paginatorMaxDots = 20
+
+// PageChangedMsg is emitted whenever the current page moves
+type PageChangedMsg struct {
+    Page, Total int // Page is zero-based
+}
+
+// Paginator tracks a page position. left/right (h/l, pgup/pgdown) step,
+// home/end (g/G) jump to the ends.
+type Paginator struct {
+    Kind    PaginatorKind
+    PerPage int
+    page    int
+    total   int
+    focused bool
+}
+
+func NewPaginator(perPage int) Paginator {
+    return Paginator{PerPage: max(perPage, 1), total: 1}
+}
+
+func (p *Paginator) Focus() {
+    p.focused = true
+}
+
+func (p *Paginator) Blur() {
+    p.focused = false
+}
+
+func (p Paginator) Focused() bool {
+    return p.focused
+}
+
+func (p Paginator) Page() int {
+    return p.page
+}
+
+func (p Paginator) Total() int {
+    return p.total
+}
+
+// SetItems recomputes the page count for n items, keeping the page in range
+func (p *Paginator) SetItems(n int) {
+    p.PerPage = max(p.PerPage, 1)
+    p.total = max((n+p.PerPage-1)/p.PerPage, 1)
+    p.page = clamp(p.page, 0, p.total-1)
+}
+
+// Bounds returns the slice bounds of the current page within n items
+func (p Paginator) Bounds(n int) (start, end int) {
+    start = min(p.page*max(p.PerPage, 1), n)
+    return start, min(start+max(p.PerPage, 1), n)
+}
+
+// SetPage moves to page i, clamped; the command reports a real move
+func (p *Paginator) SetPage(i int) tea.Cmd {
+    i = clamp(i, 0, p.total-1)
+    if i == p.page {
+        return nil
+    }
+    p.page = i
+    msg := PageChangedMsg{Page: p.page, Total: p.total}
+    return func() tea.Msg { return msg }
+}
+
+func (p Paginator) Update(msg tea.Msg) (Paginator, tea.Cmd) {
+    if !p.focused {
+        return p, nil
+    }
+    if msg, ok := msg.(tea.KeyMsg); ok {
+        switch msg.String() {
+        case "left", "h", "pgup":
+            return p, p.SetPage(p.page - 1)
+        case "right", "l", "pgdown":
+            return p, p.SetPage(p.page + 1)
+        case "home", "g":
+            return p, p.SetPage(0)
+        case "end", "G":
+            return p, p.SetPage(p.total - 1)
+        }
+    }
+    return p, nil
+}
+
+func (p Paginator) View() string {
+    on := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+    off := lipgloss.NewStyle().Foreground(theme.Border)
+
+    if p.Kind == PagesDots && p.total <= paginatorMaxDots {
+        dots := make([]string, p.total)
+        for i := range dots {
+            if i == p.page {
+                dots[i] = on.Render("●")
+            } else {
+                dots[i] = off.Render("○")
+            }
+        }
+        return strings.Join(dots, " ")
+    }
+
+    arrow := func(s string, enabled bool) string {
+        if !enabled {
+            return off.Render(s)
+        }
+        if p.focused {
+            return on.Render(s)
+        }
+        return lipgloss.NewStyle().Foreground(theme.Subtext).Render(s)
+    }
+    pos := theme.Derive(lipgloss.NewStyle()).Render(strconv.Itoa(p.page+1)) +
+        theme.Caption.Render("/"+strconv.Itoa(p.total))
+    return arrow("←", p.page > 0) + " " + pos + " " + arrow("→", p.page < p.total-1)
+}
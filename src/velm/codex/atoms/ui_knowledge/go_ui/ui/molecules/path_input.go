@@ -0,0 +1,188 @@
+package molecules
+
+import (
+    "os"
+    "path/filepath"
+    "sort"
+    "strings"
+    "unicode/utf8"
+
+    "github.com/charmbracelet/bubbles/textinput"
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+const pathMaxCandidates = 5 // Completions listed under the input
+
+// PathInput is a text input for filesystem paths. tab completes from the
+// directory being typed (repeated tabs cycle through ambiguous matches),
+// a leading ~ means the home directory, and paths that do not exist are
+// shown in warning colors.
+type PathInput struct {
+    textinput.Model
+    DirsOnly   bool
+    candidates []string // Matches offered by the last tab
+    cycle      int      // Index into candidates while cycling, -1 before
+    missing    bool
+    text       lipgloss.Style
+}
+
+func NewPathInput() PathInput {
+    ti := textinput.New()
+    ti.Prompt = atoms.Icon("folder") + " "
+    ti.Placeholder = "~/"
+    ti.Width = 40
+    ti.PromptStyle = lipgloss.NewStyle().Foreground(theme.Primary)
+    ti.TextStyle = lipgloss.NewStyle().Foreground(theme.Text)
+    ti.PlaceholderStyle = lipgloss.NewStyle().Foreground(theme.Subtext)
+    return PathInput{Model: ti, cycle: -1, text: ti.TextStyle}
+}
+
+// Path returns the typed path with ~ expanded
+func (p PathInput) Path() string {
+    return expandHome(p.Value())
+}
+
+// Exists reports whether the typed path is present on disk
+func (p PathInput) Exists() bool {
+    return p.Value() != "" && !p.missing
+}
+
+func expandHome(path string) string {
+    if path != "~" && !strings.HasPrefix(path, "~/") {
+        return path
+    }
+    home, err := os.UserHomeDir()
+    if err != nil {
+        return path
+    }
+    return filepath.Join(home, path[1:])
+}
+
+// check refreshes the missing flag and text color after an edit
+func (p *PathInput) check() {
+    _, err := os.Stat(p.Path())
+    p.missing = p.Value() != "" && err != nil
+    p.TextStyle = p.text
+    if p.missing {
+        p.TextStyle = p.text.Copy().Foreground(theme.Warning)
+    }
+}
+
+// complete extends the value from the entries of its directory
+func (p *PathInput) complete() {
+    if p.cycle >= 0 && len(p.candidates) > 1 {
+        p.cycle = (p.cycle + 1) % len(p.candidates)
+        p.set(p.candidates[p.cycle])
+        return
+    }
+
+    value := p.Value()
+    dir, prefix := filepath.Split(value)
+    entries, err := os.ReadDir(expandHome(dir))
+    if dir == "" {
+        entries, err = os.ReadDir(".")
+    }
+    if err != nil {
+        p.candidates = nil
+        return
+    }
+
+    var matches []string
+    for _, e := range entries {
+        name := e.Name()
+        if !strings.HasPrefix(name, prefix) || (strings.HasPrefix(name, ".") && !strings.HasPrefix(prefix, ".")) {
+            continue
+        }
+        isDir := e.IsDir()
+        if e.Type()&os.ModeSymlink != 0 {
+            if info, err := os.Stat(filepath.Join(expandHome(dir), name)); err == nil {
+                isDir = info.IsDir()
+            }
+        }
+        if p.DirsOnly && !isDir {
+            continue
+        }
+        if isDir {
+            name += string(filepath.Separator)
+        }
+        matches = append(matches, dir+name)
+    }
+    sort.Strings(matches)
+    p.candidates = matches
+
+    switch len(matches) {
+    case 0:
+    case 1:
+        p.set(matches[0])
+    default:
+        if common := commonPrefix(matches); len(common) > len(value) {
+            p.set(common)
+        } else {
+            // Nothing left in common: the next tab starts cycling
+            p.cycle = 0
+            p.set(matches[0])
+        }
+    }
+}
+
+func (p *PathInput) set(v string) {
+    p.SetValue(v)
+    p.CursorEnd()
+    p.check()
+}
+
+// commonPrefix is the longest start all items share, cut on a rune boundary
+func commonPrefix(items []string) string {
+    prefix := items[0]
+    for _, s := range items[1:] {
+        for !strings.HasPrefix(s, prefix) {
+            _, size := utf8.DecodeLastRuneInString(prefix)
+            prefix = prefix[:len(prefix)-size]
+        }
+    }
+    return prefix
+}
+
+func (p PathInput) Update(msg tea.Msg) (PathInput, tea.Cmd) {
+    if key, ok := msg.(tea.KeyMsg); ok && p.Focused() {
+        if key.String() == "tab" {
+            p.complete()
+            return p, nil
+        }
+    }
+
+    before := p.Value()
+    var cmd tea.Cmd
+    p.Model, cmd = p.Model.Update(msg)
+    if p.Value() != before {
+        p.candidates, p.cycle = nil, -1
+        p.check()
+    }
+    return p, cmd
+}
+
+func (p PathInput) View() string {
+    view := p.Model.View()
+    if p.missing {
+        view += " " + lipgloss.NewStyle().Foreground(theme.Warning).Render(atoms.Icon("warning")+" not found")
+    }
+    if len(p.candidates) > 1 {
+        var names []string
+        for i, c := range p.candidates {
+            if i == pathMaxCandidates {
+                names = append(names, "…")
+                break
+            }
+            name := filepath.Base(strings.TrimSuffix(c, string(filepath.Separator)))
+            if i == p.cycle {
+                name = lipgloss.NewStyle().Foreground(theme.Primary).Bold(true).Render(name)
+            }
+            names = append(names, name)
+        }
+        view += "\n" + theme.Caption.Render(strings.Join(names, "  "))
+    }
+    return view
+}
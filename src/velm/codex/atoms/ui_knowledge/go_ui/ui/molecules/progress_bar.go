@@ -2,23 +2,92 @@ package molecules
 
 import (
     "github.com/charmbracelet/bubbles/progress"
+    tea "github.com/charmbracelet/bubbletea"
     "github.com/charmbracelet/lipgloss"
     "gnostic-tui/ui/theme"
 )
 
-func NewProgressBar(width int) progress.Model {
-    return progress.New(
-        progress.WithDefaultGradient(),
-        progress.WithWidth(width),
-        progress.WithoutPercentage(),
-    )
+type progressConfig struct {
+    thresholds        bool
+    warning, critical float64
+}
+
+// ProgressOption customizes a ProgressBar
+type ProgressOption func(*progressConfig)
+
+// ProgressThresholds swaps the gradient for a solid fill that turns from Accent
+// to Warning and then Danger as the fill ratio (0..1) crosses each limit
+func ProgressThresholds(warning, critical float64) ProgressOption {
+    return func(c *progressConfig) {
+        c.thresholds = true
+        c.warning, c.critical = warning, critical
+    }
+}
+
+// ProgressBar is a bubbles progress bar whose color can follow its value
+type ProgressBar struct {
+    progress.Model
+    cfg progressConfig
+}
+
+func NewProgressBar(width int, opts ...ProgressOption) ProgressBar {
+    var cfg progressConfig
+    for _, opt := range opts {
+        opt(&cfg)
+    }
+
+    fill := progress.WithDefaultGradient()
+    if cfg.thresholds {
+        fill = progress.WithSolidFill(string(theme.Accent))
+    }
+    return ProgressBar{
+        Model: progress.New(
+            fill,
+            progress.WithWidth(width),
+            progress.WithoutPercentage(),
+        ),
+        cfg: cfg,
+    }
+}
+
+// fillFor picks the threshold color for a fill ratio
+func (p ProgressBar) fillFor(percent float64) string {
+    switch {
+    case percent >= p.cfg.critical:
+        return string(theme.Danger)
+    case percent >= p.cfg.warning:
+        return string(theme.Warning)
+    default:
+        return string(theme.Accent)
+    }
+}
+
+// Update forwards animation frames to the underlying bar
+func (p ProgressBar) Update(msg tea.Msg) (ProgressBar, tea.Cmd) {
+    m, cmd := p.Model.Update(msg)
+    p.Model = m.(progress.Model)
+    return p, cmd
+}
+
+func (p ProgressBar) View() string {
+    if p.cfg.thresholds {
+        p.FullColor = p.fillFor(p.Percent())
+    }
+    return p.Model.View()
+}
+
+func (p ProgressBar) ViewAs(percent float64) string {
+    if p.cfg.thresholds {
+        p.FullColor = p.fillFor(percent)
+    }
+    return p.Model.ViewAs(percent)
 }
 
 // RenderWithLabel adds a label above the bar
-func RenderProgress(p progress.Model, label string) string {
+func RenderProgress(p ProgressBar, label string) string {
     return lipgloss.JoinVertical(
         lipgloss.Left,
         lipgloss.NewStyle().Foreground(theme.Subtext).MarginBottom(1).Render(label),
         p.View(),
     )
-}
\ No newline at end of file
+}
@@ -0,0 +1,123 @@
+package molecules
+
+import (
+    "time"
+
+    "github.com/charmbracelet/bubbles/textinput"
+    tea "github.com/charmbracelet/bubbletea"
+)
+
+const searchHistoryMax = 50
+
+// SearchChangedMsg reports the query once typing has paused for the debounce
+// interval, and straight away on enter or when esc clears the input
+type SearchChangedMsg struct {
+    Query string
+}
+
+// searchDebounceMsg fires after a pause; only the latest edit's seq counts
+type searchDebounceMsg struct {
+    id, seq int
+}
+
+// SearchInput is the themed search box. Edits are debounced into
+// SearchChangedMsg, esc clears the query, enter records it in the history and
+// up/down recall earlier queries.
+type SearchInput struct {
+    textinput.Model
+    Debounce time.Duration
+    history  []string // Oldest first
+    recall   int      // Index into history while browsing, len(history) otherwise
+    draft    string   // Query being typed before browsing started
+    seq      int
+    id       int
+}
+
+func NewSearchInput() SearchInput {
+    return SearchInput{Model: searchTextInput(), Debounce: 250 * time.Millisecond, id: nextID()}
+}
+
+// History returns the recorded queries, oldest first
+func (s SearchInput) History() []string {
+    return append([]string(nil), s.history...)
+}
+
+func (s *SearchInput) remember(q string) {
+    if q != "" && (len(s.history) == 0 || s.history[len(s.history)-1] != q) {
+        s.history = append(s.history, q)
+        if len(s.history) > searchHistoryMax {
+            s.history = s.history[1:]
+        }
+    }
+    s.recall = len(s.history)
+}
+
+// show puts q in the box without going through the debounce
+func (s *SearchInput) show(q string) tea.Cmd {
+    s.SetValue(q)
+    s.CursorEnd()
+    s.seq++ // Cancel any pending debounce
+    return changed(q)
+}
+
+func changed(q string) tea.Cmd {
+    return func() tea.Msg { return SearchChangedMsg{Query: q} }
+}
+
+func (s SearchInput) Update(msg tea.Msg) (SearchInput, tea.Cmd) {
+    switch msg := msg.(type) {
+    case searchDebounceMsg:
+        if msg.id == s.id && msg.seq == s.seq {
+            return s, changed(s.Value())
+        }
+        return s, nil
+    case tea.KeyMsg:
+        if !s.Focused() {
+            break
+        }
+        switch msg.String() {
+        case "esc":
+            s.recall = len(s.history)
+            if s.Value() == "" {
+                return s, nil
+            }
+            return s, s.show("")
+        case "enter":
+            s.remember(s.Value())
+            return s, s.show(s.Value())
+        case "up":
+            if s.recall == 0 {
+                return s, nil
+            }
+            if s.recall == len(s.history) {
+                s.draft = s.Value()
+            }
+            s.recall--
+            return s, s.show(s.history[s.recall])
+        case "down":
+            if s.recall >= len(s.history) {
+                return s, nil
+            }
+            s.recall++
+            if s.recall == len(s.history) {
+                return s, s.show(s.draft)
+            }
+            return s, s.show(s.history[s.recall])
+        }
+    }
+
+    before := s.Value()
+    var cmd tea.Cmd
+    s.Model, cmd = s.Model.Update(msg)
+    if s.Value() == before {
+        return s, cmd
+    }
+
+    s.seq++
+    s.recall = len(s.history)
+    id, seq := s.id, s.seq
+    debounce := tea.Tick(s.Debounce, func(time.Time) tea.Msg {
+        return searchDebounceMsg{id: id, seq: seq}
+    })
+    return s, tea.Batch(cmd, debounce)
+}
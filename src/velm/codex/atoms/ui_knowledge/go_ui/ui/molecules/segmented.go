@@ -0,0 +1,126 @@
+package molecules
+
+import (
+    "strconv"
+    "strings"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
+)
+
+// Segmented renders options as one pill with the selected segment filled:
+// ▐ Day │ Week │ Month ▌
+func Segmented(options []string, selected int) string {
+    return renderSegments(options, selected, false, "")
+}
+
+// renderSegments draws the pill; zonePrefix, when set, marks each segment
+func renderSegments(options []string, selected int, focused bool, zonePrefix string) string {
+    if len(options) == 0 {
+        return ""
+    }
+
+    on := theme.Primary
+    if !focused && zonePrefix != "" {
+        on = theme.Secondary // Quieter while another control has focus
+    }
+    fill := func(i int) lipgloss.Color {
+        if i == selected {
+            return on
+        }
+        return theme.Surface
+    }
+
+    var b strings.Builder
+    b.WriteString(lipgloss.NewStyle().Foreground(fill(0)).Render("▐"))
+    for i, opt := range options {
+        if i > 0 {
+            divider := lipgloss.NewStyle().Background(theme.Surface).Foreground(theme.Border)
+            b.WriteString(divider.Render("│"))
+        }
+        style := lipgloss.NewStyle().Background(fill(i)).Foreground(theme.Subtext)
+        if i == selected {
+            style = style.Foreground(lipgloss.Color("#ffffff")).Bold(true)
+        }
+        seg := style.Render(" " + opt + " ")
+        if zonePrefix != "" {
+            seg = zone.Mark(zonePrefix+":"+strconv.Itoa(i), seg)
+        }
+        b.WriteString(seg)
+    }
+    b.WriteString(lipgloss.NewStyle().Foreground(fill(len(options) - 1)).Render("▌"))
+    return b.String()
+}
+
+// SegmentChangedMsg is emitted when a SegmentedControl's selection moves
+type SegmentChangedMsg struct {
+    Index  int
+    Option string
+}
+
+// SegmentedControl is the interactive form of Segmented: left/right (h/l)
+// move the selection while focused, and segments can be clicked
+type SegmentedControl struct {
+    Options  []string
+    selected int
+    focused  bool
+    id       string
+}
+
+func NewSegmentedControl(options ...string) SegmentedControl {
+    return SegmentedControl{Options: options, id: zone.NewID("segmented")}
+}
+
+func (s *SegmentedControl) Focus() {
+    s.focused = true
+}
+
+func (s *SegmentedControl) Blur() {
+    s.focused = false
+}
+
+func (s SegmentedControl) Focused() bool {
+    return s.focused
+}
+
+func (s SegmentedControl) Selected() int {
+    return s.selected
+}
+
+// Select moves the selection to i; the command reports a real change
+func (s *SegmentedControl) Select(i int) tea.Cmd {
+    if i < 0 || i >= len(s.Options) || i == s.selected {
+        return nil
+    }
+    s.selected = i
+    msg := SegmentChangedMsg{Index: i, Option: s.Options[i]}
+    return func() tea.Msg { return msg }
+}
+
+func (s SegmentedControl) Update(msg tea.Msg) (SegmentedControl, tea.Cmd) {
+    switch msg := msg.(type) {
+    case tea.MouseMsg:
+        for i := range s.Options {
+            if zone.Get(s.id + ":" + strconv.Itoa(i)).Clicked(msg) {
+                return s, s.Select(i)
+            }
+        }
+    case tea.KeyMsg:
+        if !s.focused {
+            return s, nil
+        }
+        switch msg.String() {
+        case "left", "h":
+            return s, s.Select(s.selected - 1)
+        case "right", "l":
+            return s, s.Select(s.selected + 1)
+        }
+    }
+    return s, nil
+}
+
+func (s SegmentedControl) View() string {
+    return renderSegments(s.Options, s.selected, s.focused, s.id)
+}
@@ -0,0 +1,219 @@
+package molecules
+
+import (
+    "strings"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
+)
+
+const selectMaxRows = 8 // Options visible at once in the open list
+
+// Select shows the chosen option with a chevron; enter opens a list of options
+// that filters as you type. The list floats over the screen and is drawn by
+// Overlay, after the frame has gone through zone.Scan.
+type Select struct {
+    Options  []string
+    Width    int
+    selected int
+    open     bool
+    filter   string
+    matches  []int // Indexes into Options that pass the filter
+    cursor   int   // Position within matches
+    focused  bool
+    id       string
+}
+
+func NewSelect(options ...string) Select {
+    return Select{Options: options, Width: 24, id: zone.NewID("select")}
+}
+
+func (s *Select) Focus() {
+    s.focused = true
+}
+
+// Blur also closes the list
+func (s *Select) Blur() {
+    s.focused = false
+    s.Close()
+}
+
+func (s Select) Focused() bool {
+    return s.focused
+}
+
+// IsOpen reports whether the option list is showing
+func (s Select) IsOpen() bool {
+    return s.open
+}
+
+func (s Select) Selected() int {
+    return s.selected
+}
+
+// Value returns the chosen option, or "" when there are none
+func (s Select) Value() string {
+    if s.selected < 0 || s.selected >= len(s.Options) {
+        return ""
+    }
+    return s.Options[s.selected]
+}
+
+func (s *Select) SetSelected(i int) {
+    if i >= 0 && i < len(s.Options) {
+        s.selected = i
+    }
+}
+
+// Open shows the list with the cursor on the current choice
+func (s *Select) Open() {
+    s.open = true
+    s.setFilter("")
+    for i, opt := range s.matches {
+        if opt == s.selected {
+            s.cursor = i
+        }
+    }
+}
+
+func (s *Select) Close() {
+    s.open = false
+    s.filter = ""
+}
+
+func (s *Select) setFilter(f string) {
+    s.filter = f
+    // A fresh slice, as copies of the Select may still hold the old one
+    var matches []int
+    needle := strings.ToLower(f)
+    for i, opt := range s.Options {
+        if strings.Contains(strings.ToLower(opt), needle) {
+            matches = append(matches, i)
+        }
+    }
+    s.matches = matches
+    s.cursor = 0
+}
+
+func (s Select) Update(msg tea.Msg) (Select, tea.Cmd) {
+    switch msg := msg.(type) {
+    case tea.MouseMsg:
+        if zone.Get(s.id).Clicked(msg) {
+            if s.open {
+                s.Close()
+            } else {
+                s.Open()
+            }
+        }
+    case tea.KeyMsg:
+        if !s.focused {
+            return s, nil
+        }
+        if !s.open {
+            switch msg.String() {
+            case "enter", " ", "down":
+                s.Open()
+            }
+            return s, nil
+        }
+
+        switch key := msg.String(); key {
+        case "up", "ctrl+p":
+            if s.cursor > 0 {
+                s.cursor--
+            }
+        case "down", "ctrl+n":
+            if s.cursor < len(s.matches)-1 {
+                s.cursor++
+            }
+        case "enter":
+            if len(s.matches) > 0 {
+                s.selected = s.matches[s.cursor]
+            }
+            s.Close()
+        case "esc":
+            s.Close()
+        case "backspace":
+            if s.filter != "" {
+                r := []rune(s.filter)
+                s.setFilter(string(r[:len(r)-1]))
+            }
+        default:
+            if msg.Type == tea.KeyRunes {
+                s.setFilter(s.filter + string(msg.Runes))
+            }
+        }
+    }
+    return s, nil
+}
+
+func (s Select) View() string {
+    chevron := "▾"
+    if s.open {
+        chevron = "▴"
+    }
+
+    border := theme.Border
+    if s.focused {
+        border = theme.Primary
+    }
+
+    inner := max(s.Width-4, 1) // Border and the chevron column
+    value := theme.Derive(lipgloss.NewStyle().Width(inner)).
+        Render(atoms.Truncate(s.Value(), inner, "…"))
+    field := lipgloss.NewStyle().
+        Border(lipgloss.RoundedBorder()).
+        BorderForeground(border).
+        Render(value + " " + lipgloss.NewStyle().Foreground(border).Render(chevron))
+
+    return zone.Mark(s.id, field)
+}
+
+// Menu renders the open option list, or "" while closed
+func (s Select) Menu() string {
+    if !s.open {
+        return ""
+    }
+
+    inner := max(s.Width-2, 1)
+    rows := []string{theme.Caption.Render(atoms.Truncate("› "+s.filter, inner, "…"))}
+    if len(s.matches) == 0 {
+        rows = append(rows, theme.Caption.Render("No matches"))
+    }
+
+    // Keep the cursor inside the visible window
+    start := clamp(s.cursor-selectMaxRows+1, 0, max(len(s.matches)-selectMaxRows, 0))
+    end := min(start+selectMaxRows, len(s.matches))
+    for i := start; i < end; i++ {
+        opt := s.Options[s.matches[i]]
+        style := theme.Derive(lipgloss.NewStyle().Width(inner))
+        if i == s.cursor {
+            style = style.Copy().Background(theme.Primary).Foreground(lipgloss.Color("229")).Bold(true)
+        } else if s.matches[i] == s.selected {
+            style = style.Copy().Foreground(theme.Accent)
+        }
+        rows = append(rows, style.Render(atoms.Truncate(opt, inner, "…")))
+    }
+
+    return lipgloss.NewStyle().
+        Border(lipgloss.RoundedBorder()).
+        BorderForeground(theme.Primary).
+        Render(strings.Join(rows, "\n"))
+}
+
+// Overlay draws the open list just below the field in a frame that has
+// already been through zone.Scan; it returns screen unchanged otherwise
+func (s Select) Overlay(screen string) string {
+    z := zone.Get(s.id)
+    if !s.open || z.IsZero() {
+        return screen
+    }
+    return atoms.Overlay(screen, s.Menu(), z.StartX, z.EndY+1)
+}
+
+func clamp(v, lo, hi int) int {
+    return max(lo, min(v, hi))
+}
@@ -0,0 +1,129 @@
+package molecules
+
+import (
+    "fmt"
+    "sort"
+    "strings"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// Segment is one share of a StackedProgress bar
+type Segment struct {
+    Label string
+    Value float64
+    Color lipgloss.Color
+}
+
+// StackedOption customises a StackedProgress
+type StackedOption func(*stackedConfig)
+
+type stackedConfig struct {
+    width    int
+    capacity float64
+    legend   bool
+}
+
+// StackedWidth sets the bar width in cells
+func StackedWidth(w int) StackedOption {
+    return func(c *stackedConfig) { c.width = w }
+}
+
+// StackedCapacity scales the bar to capacity instead of the segment sum, leaving
+// the unused rest empty, e.g. a disk's size
+func StackedCapacity(capacity float64) StackedOption {
+    return func(c *stackedConfig) { c.capacity = capacity }
+}
+
+// StackedNoLegend hides the "■ label 45%" line under the bar
+func StackedNoLegend() StackedOption {
+    return func(c *stackedConfig) { c.legend = false }
+}
+
+// StackedProgress renders segments side by side in one bar with a legend.
+// Cells are shared out by largest remainder so the bar is always exactly the
+// given width, and every non-zero segment keeps at least one cell when it can.
+func StackedProgress(segments []Segment, opts ...StackedOption) string {
+    cfg := stackedConfig{width: 40, legend: true}
+    for _, opt := range opts {
+        opt(&cfg)
+    }
+
+    sum := 0.0
+    for _, s := range segments {
+        sum += max(s.Value, 0)
+    }
+    total := max(cfg.capacity, sum)
+    if total <= 0 || cfg.width <= 0 {
+        return lipgloss.NewStyle().Foreground(theme.Surface).Render(strings.Repeat("█", max(cfg.width, 0)))
+    }
+
+    cells := shareCells(segments, total, cfg.width)
+
+    var bar strings.Builder
+    used := 0
+    for i, s := range segments {
+        bar.WriteString(lipgloss.NewStyle().Foreground(s.Color).Render(strings.Repeat("█", cells[i])))
+        used += cells[i]
+    }
+    bar.WriteString(lipgloss.NewStyle().Foreground(theme.Surface).Render(strings.Repeat("█", cfg.width-used)))
+
+    if !cfg.legend {
+        return bar.String()
+    }
+    var legend []string
+    for _, s := range segments {
+        legend = append(legend, lipgloss.NewStyle().Foreground(s.Color).Render("■")+" "+
+            theme.Caption.Render(fmt.Sprintf("%s %.0f%%", s.Label, max(s.Value, 0)/total*100)))
+    }
+    return bar.String() + "\n" + strings.Join(legend, "  ")
+}
+
+// shareCells divides width cells among segments in proportion to value/total
+func shareCells(segments []Segment, total float64, width int) []int {
+    cells := make([]int, len(segments))
+    type rest struct {
+        i    int
+        frac float64
+    }
+    var rests []rest
+
+    filled := 0
+    exact := 0.0
+    for i, s := range segments {
+        share := max(s.Value, 0) / total * float64(width)
+        exact += share
+        cells[i] = int(share)
+        filled += cells[i]
+        rests = append(rests, rest{i, share - float64(cells[i])})
+    }
+
+    // Hand the cells lost to rounding to the largest remainders
+    sort.SliceStable(rests, func(a, b int) bool { return rests[a].frac > rests[b].frac })
+    for _, r := range rests {
+        if filled >= int(exact+0.5) {
+            break
+        }
+        cells[r.i]++
+        filled++
+    }
+
+    // Tiny segments borrow a cell from the widest one so they stay visible
+    for i, s := range segments {
+        if s.Value <= 0 || cells[i] > 0 {
+            continue
+        }
+        widest := 0
+        for j := range cells {
+            if cells[j] > cells[widest] {
+                widest = j
+            }
+        }
+        if cells[widest] > 1 {
+            cells[widest]--
+            cells[i]++
+        }
+    }
+    return cells
+}
@@ -0,0 +1,68 @@
+package molecules
+
+import (
+    "fmt"
+    "math"
+    "strings"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+// StatCardOption customises a StatCard
+type StatCardOption func(*statCardConfig)
+
+type statCardConfig struct {
+    width    int
+    inverted bool
+}
+
+// StatCardWidth sets the card's outer width
+func StatCardWidth(w int) StatCardOption {
+    return func(c *statCardConfig) { c.width = w }
+}
+
+// StatCardInverted colors rises red and falls green, for costs, latency or load
+func StatCardInverted() StatCardOption {
+    return func(c *statCardConfig) { c.inverted = true }
+}
+
+// StatCard shows a headline figure with its change in percent and a sparkline
+// of its history. value is drawn in block letters when it is plain text that
+// fits, and in bold otherwise.
+func StatCard(label, value string, delta float64, history []float64, opts ...StatCardOption) string {
+    cfg := statCardConfig{width: 30}
+    for _, opt := range opts {
+        opt(&cfg)
+    }
+    inner := cfg.width - 2*theme.Space(2) // Card padding
+
+    figure := theme.H2.Render(value)
+    if big := atoms.BigText(value); !strings.Contains(value, "\x1b") && lipgloss.Width(big) <= inner {
+        figure = big
+    }
+
+    trend := theme.Caption.Render("– 0%")
+    if delta != 0 && !math.IsNaN(delta) {
+        up := delta > 0
+        good := up != cfg.inverted
+        color, arrow := theme.Danger, "▼"
+        if good {
+            color = theme.Accent
+        }
+        if up {
+            arrow = "▲"
+        }
+        trend = lipgloss.NewStyle().Foreground(color).Bold(true).
+            Render(fmt.Sprintf("%s %.1f%%", arrow, math.Abs(delta)))
+    }
+
+    return theme.Derive(theme.CardStyle).
+        Width(cfg.width).
+        Render(lipgloss.JoinVertical(lipgloss.Left,
+            theme.Label.Render(label),
+            figure,
+            trend+"  "+atoms.Sparkline(history, inner-lipgloss.Width(trend)-2),
+        ))
+}
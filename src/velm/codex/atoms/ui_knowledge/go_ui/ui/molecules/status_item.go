@@ -1,17 +1,83 @@
 package molecules
 
 import (
-    "fmt"
+    "strings"
+
     "github.com/charmbracelet/lipgloss"
     "gnostic-tui/ui/atoms"
     "gnostic-tui/ui/theme"
 )
 
+// StatusRowOpts lays out the columns of a status row. A zero KeyWidth or
+// ValueWidth sizes that column to its content; in StatusRows the content is
+// the widest entry of the whole group.
+type StatusRowOpts struct {
+    KeyWidth   int
+    ValueWidth int
+    Align      lipgloss.Position // Alignment of the value within its column
+    Gap        int               // Cells between columns
+}
+
+// DefaultStatusRowOpts is the layout StatusRow uses
+func DefaultStatusRowOpts() StatusRowOpts {
+    return StatusRowOpts{KeyWidth: 15, ValueWidth: 20, Align: lipgloss.Left, Gap: theme.Space(1)}
+}
+
+// StatusItem is one row for StatusRows
+type StatusItem struct {
+    Key, Value string
+    Badge      string // Empty for no badge
+    Variant    atoms.BadgeVariant
+}
+
 // StatusRow renders a "Key: Value [Badge]" row
 func StatusRow(key string, value string, badge string, variant atoms.BadgeVariant) string {
-    k := lipgloss.NewStyle().Foreground(theme.Subtext).Width(15).Render(key + ":")
-    v := lipgloss.NewStyle().Foreground(theme.Text).Width(20).Render(value)
-    b := atoms.Badge(badge, variant)
+    return StatusRowWith(DefaultStatusRowOpts(), key, value, badge, variant)
+}
+
+// StatusRowWith renders a status row with an explicit layout
+func StatusRowWith(opts StatusRowOpts, key, value, badge string, variant atoms.BadgeVariant) string {
+    return renderStatusRow(resolveStatusOpts(opts, []StatusItem{{Key: key, Value: value}}),
+        StatusItem{key, value, badge, variant})
+}
+
+// StatusRows renders a group of rows sharing column widths, so the values line
+// up even when the keys differ in length
+func StatusRows(items []StatusItem, opts StatusRowOpts) string {
+    opts = resolveStatusOpts(opts, items)
+    rows := make([]string, len(items))
+    for i, item := range items {
+        rows[i] = renderStatusRow(opts, item)
+    }
+    return strings.Join(rows, "\n")
+}
+
+// resolveStatusOpts replaces zero widths with the widest key and value in items
+func resolveStatusOpts(opts StatusRowOpts, items []StatusItem) StatusRowOpts {
+    if opts.KeyWidth <= 0 {
+        for _, it := range items {
+            opts.KeyWidth = max(opts.KeyWidth, lipgloss.Width(it.Key+":"))
+        }
+    }
+    if opts.ValueWidth <= 0 {
+        for _, it := range items {
+            opts.ValueWidth = max(opts.ValueWidth, lipgloss.Width(it.Value))
+        }
+    }
+    opts.Gap = max(opts.Gap, 0)
+    return opts
+}
+
+func renderStatusRow(opts StatusRowOpts, item StatusItem) string {
+    k := lipgloss.NewStyle().Foreground(theme.Subtext).Width(opts.KeyWidth).
+        Render(atoms.Truncate(item.Key+":", opts.KeyWidth, "…"))
+    v := theme.Derive(lipgloss.NewStyle().Width(opts.ValueWidth).Align(opts.Align)).
+        Render(atoms.Truncate(item.Value, opts.ValueWidth, "…"))
 
-    return fmt.Sprintf("%s %s %s", k, v, b)
-}
\ No newline at end of file
+    gap := strings.Repeat(" ", opts.Gap)
+    row := k + gap + v
+    if item.Badge != "" {
+        row += gap + atoms.Badge(item.Badge, item.Variant)
+    }
+    return row
+}
@@ -0,0 +1,40 @@
+package molecules
+
+import (
+    "strconv"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+// Stepper renders a wizard's steps joined by connector lines, e.g.
+// "✓ Build ─── 2 Test ─── 3 Deploy". current is zero-based: earlier steps
+// show as done, later ones as pending. A current past the end marks all done.
+func Stepper(steps []string, current int) string {
+    done := lipgloss.NewStyle().Foreground(theme.Accent)
+    active := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+    pending := lipgloss.NewStyle().Foreground(theme.Border)
+
+    var out string
+    for i, step := range steps {
+        if i > 0 {
+            line := pending
+            if i <= current {
+                line = done // The path up to the active step is complete
+            }
+            out += " " + line.Render("───") + " "
+        }
+
+        switch {
+        case i < current:
+            out += done.Render(atoms.Icon("check")) + " " + lipgloss.NewStyle().Foreground(theme.Subtext).Render(step)
+        case i == current:
+            out += active.Copy().Background(theme.Primary).Foreground(lipgloss.Color("229")).
+                Render(" "+strconv.Itoa(i+1)+" ") + " " + active.Render(step)
+        default:
+            out += pending.Render(strconv.Itoa(i+1)) + " " + pending.Render(step)
+        }
+    }
+    return out
+}
@@ -0,0 +1,107 @@
+package molecules
+
+import (
+    "sort"
+    "strings"
+    "unicode"
+)
+
+// Suggester ranks completions for a query, best first
+type Suggester interface {
+    Suggest(query string) []string
+}
+
+// SuggestFunc adapts a plain function into a Suggester
+type SuggestFunc func(query string) []string
+
+func (f SuggestFunc) Suggest(query string) []string {
+    return f(query)
+}
+
+// asyncSuggester marks a Suggester that Autocomplete must run inside a command
+type asyncSuggester struct {
+    Suggester
+}
+
+// Async runs s off the update loop, for sources that block on disk or network.
+// Answers that arrive after the query has changed are dropped.
+func Async(s Suggester) Suggester {
+    return asyncSuggester{s}
+}
+
+// StaticSuggester offers the items containing the query, prefix matches first
+func StaticSuggester(items ...string) Suggester {
+    return SuggestFunc(func(query string) []string {
+        q := strings.ToLower(query)
+        var prefix, inner []string
+        for _, item := range items {
+            switch low := strings.ToLower(item); {
+            case strings.HasPrefix(low, q):
+                prefix = append(prefix, item)
+            case strings.Contains(low, q):
+                inner = append(inner, item)
+            }
+        }
+        return append(prefix, inner...)
+    })
+}
+
+// FuzzySuggester offers the items containing the query's letters in order,
+// e.g. "gnp" matches "genesis.py", ranked by how tightly they match
+func FuzzySuggester(items ...string) Suggester {
+    return SuggestFunc(func(query string) []string {
+        type hit struct {
+            item  string
+            score int
+        }
+        var hits []hit
+        for _, item := range items {
+            if score, ok := fuzzyScore(query, item); ok {
+                hits = append(hits, hit{item, score})
+            }
+        }
+        sort.SliceStable(hits, func(i, j int) bool {
+            if hits[i].score != hits[j].score {
+                return hits[i].score > hits[j].score
+            }
+            return len(hits[i].item) < len(hits[j].item)
+        })
+
+        out := make([]string, len(hits))
+        for i, h := range hits {
+            out[i] = h.item
+        }
+        return out
+    })
+}
+
+// fuzzyScore matches query as a subsequence of target. Consecutive letters and
+// letters at word starts score higher; skipped letters cost a point each.
+func fuzzyScore(query, target string) (int, bool) {
+    q := []rune(strings.ToLower(query))
+    t := []rune(target)
+    if len(q) == 0 {
+        return 0, true
+    }
+
+    score, qi, last := 0, 0, -2
+    for ti, r := range t {
+        if qi == len(q) {
+            break
+        }
+        if unicode.ToLower(r) != q[qi] {
+            continue
+        }
+        switch {
+        case ti == last+1:
+            score += 5
+        case ti == 0 || !unicode.IsLetter(t[ti-1]) || unicode.IsUpper(r):
+            score += 3
+        default:
+            score -= ti - last - 1
+        }
+        last = ti
+        qi++
+    }
+    return score, qi == len(q)
+}
@@ -0,0 +1,117 @@
+package molecules
+
+import (
+    "strings"
+
+    "github.com/charmbracelet/bubbles/textinput"
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+// TagInput collects labels as removable chips. Enter or a comma turns the
+// typed text into a chip; backspace on an empty input first highlights the
+// last chip and then removes it. Repeated tags are ignored and MaxTags, when
+// above zero, caps how many can be added.
+type TagInput struct {
+    textinput.Model
+    MaxTags int
+    tags    []string
+    armed   bool // The last chip is highlighted and goes on the next backspace
+}
+
+func NewTagInput() TagInput {
+    ti := textinput.New()
+    ti.Prompt = ""
+    ti.Placeholder = "Add tag…"
+    ti.Width = 20
+    ti.TextStyle = lipgloss.NewStyle().Foreground(theme.Text)
+    ti.PlaceholderStyle = lipgloss.NewStyle().Foreground(theme.Subtext)
+    return TagInput{Model: ti}
+}
+
+func (t TagInput) Tags() []string {
+    return append([]string(nil), t.tags...)
+}
+
+func (t *TagInput) SetTags(tags []string) {
+    t.tags = nil
+    for _, tag := range tags {
+        t.add(tag)
+    }
+}
+
+// Full reports whether MaxTags has been reached
+func (t TagInput) Full() bool {
+    return t.MaxTags > 0 && len(t.tags) >= t.MaxTags
+}
+
+func (t *TagInput) add(tag string) bool {
+    tag = strings.TrimSpace(tag)
+    if tag == "" || t.Full() {
+        return false
+    }
+    for _, existing := range t.tags {
+        if existing == tag {
+            return true // Already there; still clear the input
+        }
+    }
+    t.tags = append(t.tags, tag)
+    return true
+}
+
+func (t *TagInput) Remove(i int) {
+    if i >= 0 && i < len(t.tags) {
+        t.tags = append(t.tags[:i:i], t.tags[i+1:]...)
+    }
+    t.armed = false
+}
+
+func (t TagInput) Update(msg tea.Msg) (TagInput, tea.Cmd) {
+    if key, ok := msg.(tea.KeyMsg); ok && t.Focused() {
+        switch key.String() {
+        case "enter", ",":
+            if t.add(t.Value()) {
+                t.SetValue("")
+            }
+            t.armed = false
+            return t, nil
+        case "backspace":
+            if t.Value() == "" && len(t.tags) > 0 {
+                if t.armed {
+                    t.Remove(len(t.tags) - 1)
+                } else {
+                    t.armed = true
+                }
+                return t, nil
+            }
+        }
+        t.armed = false
+        if t.Full() {
+            return t, nil // Nothing more can be typed
+        }
+    }
+
+    var cmd tea.Cmd
+    t.Model, cmd = t.Model.Update(msg)
+    return t, cmd
+}
+
+func (t TagInput) View() string {
+    var chips []string
+    for i, tag := range t.tags {
+        opts := []atoms.TagOption{atoms.TagRemovable()}
+        if t.armed && i == len(t.tags)-1 {
+            opts = append(opts, atoms.TagSelected())
+        }
+        chips = append(chips, atoms.Tag(tag, opts...))
+    }
+
+    if t.Full() {
+        chips = append(chips, theme.Caption.Render("limit reached"))
+    } else {
+        chips = append(chips, t.Model.View())
+    }
+    return strings.Join(chips, " ")
+}
@@ -0,0 +1,104 @@
+package molecules
+
+import (
+    "fmt"
+    "strconv"
+    "time"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+// TaskProgress is a labelled progress bar that also tracks throughput, shown
+// as "45% • 12MB/s • ETA 00:32". The clock starts at the first update.
+type TaskProgress struct {
+    Label    string
+    Total    int64
+    RateUnit string // "" measures bytes; anything else counts items, e.g. "files"
+    bar      ProgressBar
+    done     int64
+    start    time.Time
+}
+
+func NewTaskProgress(label string, total int64, width int) TaskProgress {
+    return TaskProgress{Label: label, Total: total, bar: NewProgressBar(width)}
+}
+
+// Done returns the amount completed so far
+func (t TaskProgress) Done() int64 {
+    return t.done
+}
+
+// Set records the amount completed so far
+func (t *TaskProgress) Set(done int64) {
+    if t.start.IsZero() {
+        t.start = time.Now()
+    }
+    t.done = min(max(done, 0), t.Total)
+}
+
+// Add records n more completed
+func (t *TaskProgress) Add(n int64) {
+    t.Set(t.done + n)
+}
+
+// Percent returns the completed share in 0..1
+func (t TaskProgress) Percent() float64 {
+    if t.Total <= 0 {
+        return 0
+    }
+    return float64(t.done) / float64(t.Total)
+}
+
+// Rate returns the average throughput per second since the start
+func (t TaskProgress) Rate() float64 {
+    elapsed := time.Now().Sub(t.start).Seconds()
+    if t.start.IsZero() || elapsed <= 0 {
+        return 0
+    }
+    return float64(t.done) / elapsed
+}
+
+// ETA estimates the time left at the current rate; false while unknown
+func (t TaskProgress) ETA() (time.Duration, bool) {
+    rate := t.Rate()
+    if rate <= 0 {
+        return 0, t.done >= t.Total && t.Total > 0
+    }
+    return time.Duration(float64(t.Total-t.done) / rate * float64(time.Second)), true
+}
+
+func (t TaskProgress) rateText() string {
+    rate := t.Rate()
+    if t.RateUnit == "" {
+        return atoms.FormatBytes(int64(rate)) + "/s"
+    }
+    return strconv.FormatFloat(rate, 'f', 1, 64) + " " + t.RateUnit + "/s"
+}
+
+func (t TaskProgress) View() string {
+    eta := "--:--"
+    if left, ok := t.ETA(); ok {
+        eta = clock(left)
+    }
+
+    dot := theme.Caption.Render(" • ")
+    stats := theme.Derive(lipgloss.NewStyle()).Bold(true).Render(fmt.Sprintf("%3.0f%%", t.Percent()*100)) +
+        dot + theme.Caption.Render(t.rateText()) +
+        dot + theme.Caption.Render("ETA "+eta)
+
+    return lipgloss.JoinVertical(lipgloss.Left,
+        lipgloss.NewStyle().Foreground(theme.Subtext).MarginBottom(1).Render(t.Label),
+        t.bar.ViewAs(t.Percent())+"  "+stats,
+    )
+}
+
+// clock formats d as mm:ss, or h:mm:ss from an hour up
+func clock(d time.Duration) string {
+    s := int(d.Round(time.Second).Seconds())
+    if s >= 3600 {
+        return fmt.Sprintf("%d:%02d:%02d", s/3600, s/60%60, s%60)
+    }
+    return fmt.Sprintf("%02d:%02d", s/60, s%60)
+}
@@ -0,0 +1,130 @@
+package molecules
+
+import (
+    "time"
+
+    "github.com/charmbracelet/bubbles/spinner"
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+// TaskState is a step in a task's lifecycle
+type TaskState int
+
+const (
+    TaskPending TaskState = iota
+    TaskRunning
+    TaskDone
+    TaskFailed
+)
+
+// TaskStateMsg moves the TaskRow with the matching ID to a new state
+type TaskStateMsg struct {
+    ID    string
+    State TaskState
+    Err   error // Shown beside a failed task
+}
+
+// TaskStart reports that task id began running
+func TaskStart(id string) tea.Cmd {
+    return func() tea.Msg { return TaskStateMsg{ID: id, State: TaskRunning} }
+}
+
+// TaskFinish reports that task id ended, failed when err is non-nil
+func TaskFinish(id string, err error) tea.Cmd {
+    return func() tea.Msg {
+        if err != nil {
+            return TaskStateMsg{ID: id, State: TaskFailed, Err: err}
+        }
+        return TaskStateMsg{ID: id, State: TaskDone}
+    }
+}
+
+// TaskRow is one line of a task runner: a state glyph, the label and the time
+// spent, spinning while the task runs
+type TaskRow struct {
+    ID       string
+    Label    string
+    state    TaskState
+    err      error
+    started  time.Time
+    finished time.Time
+    spinner  spinner.Model
+}
+
+func NewTaskRow(id, label string) TaskRow {
+    return TaskRow{ID: id, Label: label, spinner: atoms.NewGnosticSpinner()}
+}
+
+func (t TaskRow) State() TaskState {
+    return t.state
+}
+
+// Elapsed is the running time so far, or the total once finished
+func (t TaskRow) Elapsed() time.Duration {
+    switch {
+    case t.started.IsZero():
+        return 0
+    case t.finished.IsZero():
+        return time.Since(t.started)
+    default:
+        return t.finished.Sub(t.started)
+    }
+}
+
+func (t TaskRow) Update(msg tea.Msg) (TaskRow, tea.Cmd) {
+    switch msg := msg.(type) {
+    case TaskStateMsg:
+        if msg.ID != t.ID {
+            return t, nil
+        }
+        t.state, t.err = msg.State, msg.Err
+        switch msg.State {
+        case TaskPending:
+            t.started, t.finished = time.Time{}, time.Time{}
+        case TaskRunning:
+            t.started, t.finished = time.Now(), time.Time{}
+            return t, t.spinner.Tick
+        default:
+            if t.started.IsZero() {
+                t.started = time.Now()
+            }
+            t.finished = time.Now()
+        }
+    case spinner.TickMsg:
+        if t.state == TaskRunning {
+            var cmd tea.Cmd
+            t.spinner, cmd = t.spinner.Update(msg)
+            return t, cmd
+        }
+    }
+    return t, nil
+}
+
+func (t TaskRow) View() string {
+    label := theme.Derive(lipgloss.NewStyle())
+    var glyph string
+    switch t.state {
+    case TaskRunning:
+        glyph = t.spinner.View()
+        label = label.Bold(true)
+    case TaskDone:
+        glyph = lipgloss.NewStyle().Foreground(theme.Accent).Render(atoms.Icon("check"))
+    case TaskFailed:
+        glyph = lipgloss.NewStyle().Foreground(theme.Danger).Render(atoms.Icon("cross"))
+    default:
+        glyph = lipgloss.NewStyle().Foreground(theme.Border).Render("○")
+        label = label.Foreground(theme.Subtext)
+    }
+
+    row := glyph + " " + label.Render(t.Label)
+    if t.state != TaskPending {
+        row += "  " + theme.Caption.Render(atoms.FormatDuration(t.Elapsed()))
+    }
+    if t.state == TaskFailed && t.err != nil {
+        row += "  " + lipgloss.NewStyle().Foreground(theme.Danger).Render(t.err.Error())
+    }
+    return row
+}
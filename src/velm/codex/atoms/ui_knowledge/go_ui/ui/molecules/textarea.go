@@ -0,0 +1,118 @@
+package molecules
+
+import (
+    "github.com/charmbracelet/bubbles/textarea"
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+const unwrappedWidth = 512 // Inner width used while soft wrap is off
+
+// TextArea is a themed multi-line editor with line numbers. It grows one row per
+// line up to MaxRows and scrolls beyond that.
+type TextArea struct {
+    textarea.Model
+    MaxRows int
+    width   int // Outer width, border included
+    wrap    bool
+}
+
+func NewTextArea(width, maxRows int) TextArea {
+    ta := textarea.New()
+    ta.Prompt = ""
+    ta.ShowLineNumbers = true
+    ta.MaxHeight = 0 // No cap on content, only on the visible rows
+    ta.FocusedStyle, ta.BlurredStyle = textAreaStyles()
+
+    t := TextArea{Model: ta, MaxRows: maxRows, width: width, wrap: true}
+    t.layout()
+    return t
+}
+
+func textAreaStyles() (focused, blurred textarea.Style) {
+    focused = textarea.Style{
+        Base:             lipgloss.NewStyle(),
+        CursorLine:       lipgloss.NewStyle().Background(theme.Surface).Foreground(theme.Text),
+        CursorLineNumber: lipgloss.NewStyle().Foreground(theme.Primary),
+        EndOfBuffer:      lipgloss.NewStyle().Foreground(theme.Surface),
+        LineNumber:       lipgloss.NewStyle().Foreground(theme.Border),
+        Placeholder:      lipgloss.NewStyle().Foreground(theme.Subtext),
+        Prompt:           lipgloss.NewStyle().Foreground(theme.Border),
+        Text:             lipgloss.NewStyle().Foreground(theme.Text),
+    }
+    blurred = focused
+    blurred.CursorLine = lipgloss.NewStyle().Foreground(theme.Subtext)
+    blurred.CursorLineNumber = focused.LineNumber
+    blurred.Text = lipgloss.NewStyle().Foreground(theme.Subtext)
+    return focused, blurred
+}
+
+// Wrap reports whether long lines soft-wrap
+func (t TextArea) Wrap() bool {
+    return t.wrap
+}
+
+// SetWrap turns soft wrap on or off; unwrapped lines are clipped at the right edge
+func (t *TextArea) SetWrap(on bool) {
+    t.wrap = on
+    t.layout()
+}
+
+func (t *TextArea) ToggleWrap() {
+    t.SetWrap(!t.wrap)
+}
+
+// SetLineNumbers shows or hides the gutter, keeping the outer width unchanged
+func (t *TextArea) SetLineNumbers(on bool) {
+    t.ShowLineNumbers = on
+    t.layout()
+}
+
+func (t *TextArea) SetValue(s string) {
+    t.Model.SetValue(s)
+    t.layout()
+}
+
+// SetSize changes the outer width and the row limit
+func (t *TextArea) SetSize(width, maxRows int) {
+    t.width, t.MaxRows = width, maxRows
+    t.layout()
+}
+
+// layout re-derives the inner width and grows the visible rows with the content
+func (t *TextArea) layout() {
+    if t.wrap {
+        t.Model.SetWidth(t.width - 2) // Border
+    } else {
+        t.Model.SetWidth(unwrappedWidth)
+    }
+
+    rows := t.LineCount()
+    if t.MaxRows > 0 && rows > t.MaxRows {
+        rows = t.MaxRows
+    }
+    t.Model.SetHeight(rows)
+}
+
+func (t TextArea) Update(msg tea.Msg) (TextArea, tea.Cmd) {
+    var cmd tea.Cmd
+    t.Model, cmd = t.Model.Update(msg)
+    t.layout()
+    return t, cmd
+}
+
+func (t TextArea) View() string {
+    inner := t.Model.View()
+    if !t.wrap {
+        inner = lipgloss.NewStyle().MaxWidth(t.width - 2).Render(inner)
+    }
+
+    frame := lipgloss.NewStyle().
+        Border(lipgloss.RoundedBorder()).
+        BorderForeground(theme.Border)
+    if t.Focused() {
+        frame = frame.BorderForeground(theme.Primary)
+    }
+    return frame.Render(inner)
+}
@@ -0,0 +1,195 @@
+package molecules
+
+import (
+    "fmt"
+    "strings"
+    "time"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// TimeSelectedMsg is emitted when a time is confirmed with enter
+type TimeSelectedMsg struct {
+    Hour, Minute, Second int
+}
+
+// timeColumn identifies a wheel of the TimePicker
+type timeColumn int
+
+const (
+    colHour timeColumn = iota
+    colMinute
+    colSecond
+    colMeridiem // Only reachable in 12-hour mode
+)
+
+// TimePicker shows hour, minute and second wheels; left/right (h/l) move
+// between them and up/down (k/j) turn the focused one, wrapping around.
+// Location only affects the zone shown beside the wheels and On.
+type TimePicker struct {
+    TwelveHour  bool
+    ShowSeconds bool
+    Location    *time.Location
+    hour        int // Always 0..23, even in 12-hour mode
+    minute      int
+    second      int
+    column      timeColumn
+    focused     bool
+}
+
+func NewTimePicker() TimePicker {
+    now := time.Now()
+    return TimePicker{
+        ShowSeconds: true,
+        Location:    time.Local,
+        hour:        now.Hour(),
+        minute:      now.Minute(),
+        second:      now.Second(),
+    }
+}
+
+func (t *TimePicker) Focus() {
+    t.focused = true
+}
+
+func (t *TimePicker) Blur() {
+    t.focused = false
+}
+
+func (t TimePicker) Focused() bool {
+    return t.focused
+}
+
+// Clock returns the chosen time of day on a 24-hour clock
+func (t TimePicker) Clock() (hour, minute, second int) {
+    return t.hour, t.minute, t.second
+}
+
+// SetClock sets the time of day, wrapping out-of-range parts
+func (t *TimePicker) SetClock(hour, minute, second int) {
+    t.hour, t.minute, t.second = wrap(hour, 24), wrap(minute, 60), wrap(second, 60)
+}
+
+// On places the chosen time on date's day in the picker's Location, e.g. to
+// combine it with a DatePicker selection
+func (t TimePicker) On(date time.Time) time.Time {
+    loc := t.Location
+    if loc == nil {
+        loc = time.Local
+    }
+    y, m, d := date.Date()
+    return time.Date(y, m, d, t.hour, t.minute, t.second, 0, loc)
+}
+
+func (t TimePicker) columns() []timeColumn {
+    cols := []timeColumn{colHour, colMinute}
+    if t.ShowSeconds {
+        cols = append(cols, colSecond)
+    }
+    if t.TwelveHour {
+        cols = append(cols, colMeridiem)
+    }
+    return cols
+}
+
+func (t *TimePicker) turn(delta int) {
+    switch t.column {
+    case colHour:
+        t.hour = wrap(t.hour+delta, 24)
+    case colMinute:
+        t.minute = wrap(t.minute+delta, 60)
+    case colSecond:
+        t.second = wrap(t.second+delta, 60)
+    case colMeridiem:
+        t.hour = wrap(t.hour+12, 24)
+    }
+}
+
+func (t TimePicker) Update(msg tea.Msg) (TimePicker, tea.Cmd) {
+    if !t.focused {
+        return t, nil
+    }
+    if msg, ok := msg.(tea.KeyMsg); ok {
+        cols := t.columns()
+        pos := 0
+        for i, c := range cols {
+            if c == t.column {
+                pos = i
+            }
+        }
+
+        switch msg.String() {
+        case "left", "h", "shift+tab":
+            t.column = cols[wrap(pos-1, len(cols))]
+        case "right", "l", "tab":
+            t.column = cols[wrap(pos+1, len(cols))]
+        case "up", "k":
+            t.turn(-1)
+        case "down", "j":
+            t.turn(1)
+        case "enter":
+            sel := TimeSelectedMsg{Hour: t.hour, Minute: t.minute, Second: t.second}
+            return t, func() tea.Msg { return sel }
+        }
+    }
+    return t, nil
+}
+
+// label spells a column's value at an offset from the current one
+func (t TimePicker) label(c timeColumn, offset int) string {
+    switch c {
+    case colHour:
+        h := wrap(t.hour+offset, 24)
+        if t.TwelveHour {
+            h = wrap(h-1, 12) + 1
+        }
+        return fmt.Sprintf("%02d", h)
+    case colMinute:
+        return fmt.Sprintf("%02d", wrap(t.minute+offset, 60))
+    case colSecond:
+        return fmt.Sprintf("%02d", wrap(t.second+offset, 60))
+    default:
+        if (t.hour < 12) == (offset == 0) {
+            return "AM"
+        }
+        return "PM"
+    }
+}
+
+func (t TimePicker) View() string {
+    dim := lipgloss.NewStyle().Foreground(theme.Border)
+
+    var wheels []string
+    for i, c := range t.columns() {
+        current := theme.Derive(lipgloss.NewStyle()).Bold(true)
+        if t.focused && c == t.column {
+            current = current.Copy().Background(theme.Primary).Foreground(lipgloss.Color("229"))
+        }
+
+        sep := " "
+        if i > 0 && c != colMeridiem {
+            sep = ":"
+        }
+        if i > 0 {
+            wheels = append(wheels, "\n"+theme.Caption.Render(sep)+"\n")
+        }
+
+        wheels = append(wheels, strings.Join([]string{
+            dim.Render(t.label(c, -1)),
+            current.Render(t.label(c, 0)),
+            dim.Render(t.label(c, 1)),
+        }, "\n"))
+    }
+
+    zoneName := theme.Caption.Render(t.On(time.Now()).Format("MST -07:00"))
+    wheels = append(wheels, "\n  "+zoneName+"\n")
+
+    return lipgloss.JoinHorizontal(lipgloss.Top, wheels...)
+}
+
+// wrap reduces v into 0..n-1, also for negative v
+func wrap(v, n int) int {
+    return ((v % n) + n) % n
+}
@@ -0,0 +1,153 @@
+package molecules
+
+import (
+    "time"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/toast"
+)
+
+// toastExpiredMsg retires the toast with the matching id
+type toastExpiredMsg struct {
+    id int
+}
+
+type toastItem struct {
+    id    int
+    msg   toast.Msg
+    shown bool // Its timer runs only once it is on screen
+}
+
+// Toaster shows toast.Msg notifications stacked in a corner of the screen.
+// At most Max are visible; the rest wait their turn and each expires after
+// its Duration once shown. Route every message through Update and draw the
+// stack with Overlay on the finished frame.
+type Toaster struct {
+    Max      int
+    Duration time.Duration
+    Width    int
+    H, V     lipgloss.Position // Corner, lipgloss.Right and lipgloss.Bottom by default
+    items    []toastItem
+    screenW  int
+    screenH  int
+}
+
+func NewToaster() Toaster {
+    return Toaster{
+        Max:      3,
+        Duration: 3 * time.Second,
+        Width:    36,
+        H:        lipgloss.Right,
+        V:        lipgloss.Bottom,
+    }
+}
+
+// Len returns the number of toasts shown or waiting
+func (t Toaster) Len() int {
+    return len(t.items)
+}
+
+// promote starts the timers of waiting toasts that now fit on screen
+func (t *Toaster) promote() tea.Cmd {
+    var cmds []tea.Cmd
+    for i := range t.items {
+        if i >= t.Max {
+            break
+        }
+        if t.items[i].shown {
+            continue
+        }
+        t.items[i].shown = true
+
+        d := t.items[i].msg.Duration
+        if d <= 0 {
+            d = t.Duration
+        }
+        id := t.items[i].id
+        cmds = append(cmds, tea.Tick(d, func(time.Time) tea.Msg {
+            return toastExpiredMsg{id: id}
+        }))
+    }
+    return tea.Batch(cmds...)
+}
+
+func (t Toaster) Update(msg tea.Msg) (Toaster, tea.Cmd) {
+    switch msg := msg.(type) {
+    case tea.WindowSizeMsg:
+        t.screenW, t.screenH = msg.Width, msg.Height
+    case toast.Msg:
+        t.items = append(t.items, toastItem{id: nextID(), msg: msg})
+        return t, t.promote()
+    case toastExpiredMsg:
+        for i, item := range t.items {
+            if item.id == msg.id {
+                t.items = append(t.items[:i:i], t.items[i+1:]...)
+                break
+            }
+        }
+        return t, t.promote()
+    }
+    return t, nil
+}
+
+func toastStyle(k toast.Kind) (lipgloss.Color, string) {
+    switch k {
+    case toast.KindSuccess:
+        return theme.Accent, atoms.Icon("check")
+    case toast.KindWarning:
+        return theme.Warning, atoms.Icon("warning")
+    case toast.KindError:
+        return theme.Danger, atoms.Icon("cross")
+    default:
+        return theme.Primary, atoms.Icon("info")
+    }
+}
+
+// View renders the visible toasts, newest nearest the corner
+func (t Toaster) View() string {
+    var boxes []string
+    for _, item := range t.items {
+        if !item.shown {
+            continue
+        }
+        color, icon := toastStyle(item.msg.Kind)
+        box := lipgloss.NewStyle().
+            Border(lipgloss.RoundedBorder()).
+            BorderForeground(color).
+            Padding(0, theme.Space(1)).
+            Width(t.Width - 2).
+            Render(lipgloss.NewStyle().Foreground(color).Bold(true).Render(icon) + " " +
+                theme.Derive(lipgloss.NewStyle()).Render(item.msg.Text))
+        boxes = append(boxes, box)
+    }
+    if len(boxes) == 0 {
+        return ""
+    }
+    if t.V == lipgloss.Top {
+        // Newest first, right under the top edge
+        for i, j := 0, len(boxes)-1; i < j; i, j = i+1, j-1 {
+            boxes[i], boxes[j] = boxes[j], boxes[i]
+        }
+    }
+    return lipgloss.JoinVertical(t.H, boxes...)
+}
+
+// Overlay draws the stack over screen in the configured corner. The screen
+// size comes from the last WindowSizeMsg, falling back to screen's own size.
+func (t Toaster) Overlay(screen string) string {
+    stack := t.View()
+    if stack == "" {
+        return screen
+    }
+
+    w, h := t.screenW, t.screenH
+    if w == 0 || h == 0 {
+        w, h = lipgloss.Width(screen), lipgloss.Height(screen)
+    }
+    x := int(float64(w-lipgloss.Width(stack)) * float64(t.H))
+    y := int(float64(h-lipgloss.Height(stack)) * float64(t.V))
+    return atoms.Overlay(screen, stack, x, y)
+}
@@ -0,0 +1,93 @@
+package molecules
+
+import (
+    "errors"
+    "fmt"
+    "regexp"
+    "strconv"
+    "strings"
+    "unicode/utf8"
+)
+
+// Validator checks a value and explains why it is rejected. Any func(string) error
+// converts to one, so custom checks need no adapter.
+type Validator func(string) error
+
+// Validate runs validators in order and returns the first failure, or nil.
+// Every validator except Required accepts the empty string, so optional fields
+// stay valid until something is typed.
+func Validate(value string, validators ...Validator) error {
+    for _, v := range validators {
+        if err := v(value); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// Required rejects empty and whitespace-only values
+func Required() Validator {
+    return func(s string) error {
+        if strings.TrimSpace(s) == "" {
+            return errors.New("this field is required")
+        }
+        return nil
+    }
+}
+
+// Pattern rejects values that do not match re, reporting msg
+func Pattern(re *regexp.Regexp, msg string) Validator {
+    return func(s string) error {
+        if s != "" && !re.MatchString(s) {
+            return errors.New(msg)
+        }
+        return nil
+    }
+}
+
+// MinLength rejects values shorter than n characters
+func MinLength(n int) Validator {
+    return func(s string) error {
+        if s != "" && utf8.RuneCountInString(s) < n {
+            return fmt.Errorf("must be at least %d characters", n)
+        }
+        return nil
+    }
+}
+
+// MaxLength rejects values longer than n characters
+func MaxLength(n int) Validator {
+    return func(s string) error {
+        if utf8.RuneCountInString(s) > n {
+            return fmt.Errorf("must be at most %d characters", n)
+        }
+        return nil
+    }
+}
+
+// Range rejects values that are not numbers between lo and hi inclusive
+func Range(lo, hi float64) Validator {
+    return func(s string) error {
+        if s == "" {
+            return nil
+        }
+        n, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+        if err != nil {
+            return errors.New("must be a number")
+        }
+        if n < lo || n > hi {
+            return fmt.Errorf("must be between %g and %g", lo, hi)
+        }
+        return nil
+    }
+}
+
+// Check adapts a predicate into a Validator that reports msg when it returns false
+func Check(ok func(string) bool, msg string) Validator {
+    return func(s string) error {
+        if !ok(s) {
+            return errors.New(msg)
+        }
+        return nil
+    }
+}
@@ -1,45 +1,825 @@
 package organisms
 
 import (
+    "context"
+    "maps"
+    "sort"
+    "strconv"
+    "strings"
+    "time"
+
     "github.com/charmbracelet/bubbles/table"
+    "github.com/charmbracelet/bubbles/textinput"
+    tea "github.com/charmbracelet/bubbletea"
     "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/molecules"
+    "gnostic-tui/ui/state"
     "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
+)
+
+// Row is one record of a DataTable, a cell per column
+type Row []string
+
+// Comparator orders two cell values, returning <0, 0 or >0 like strings.Compare
+type Comparator func(a, b string) int
+
+// Column describes one DataTable column. Width alone gives a fixed column;
+// the other sizing fields only apply once the table is given a width.
+type Column struct {
+    Title     string
+    Width     int
+    Compare   Comparator   // Sort order of the column; nil sorts as text
+    Render    CellRenderer // Draws the cells; nil prints the value
+    Auto      bool         // Size to the widest cell instead of Width
+    Flex      int          // Share of spare width, relative to other flexible columns
+    MinWidth  int          // Narrowest the column may shrink to; 0 never shrinks it
+    MaxWidth  int          // Widest it may grow to; 0 is unbounded
+    Priority  int          // Columns hide lowest priority first when space runs out
+    Aggregate Aggregator   // Value for the footer row of aggregates; nil leaves it blank
+
+    Editable   bool                  // Enter on the cell opens an editor
+    Options    []string              // Allowed values; edited with a select instead of free text
+    Validators []molecules.Validator // Checked before a typed edit is accepted
+}
+
+// SortDir is the sort direction of a column
+type SortDir int
+
+const (
+    SortNone SortDir = iota
+    SortAsc
+    SortDesc
 )
 
-func NewDataTable() table.Model {
-    columns := []table.Column{
-        {Title: "ID", Width: 5},
-        {Title: "Scripture", Width: 20},
-        {Title: "Status", Width: 10},
-        {Title: "Size", Width: 10},
+// next cycles none → ascending → descending → none
+func (d SortDir) next() SortDir {
+    return (d + 1) % 3
+}
+
+func (d SortDir) glyph() string {
+    switch d {
+    case SortAsc:
+        return "▲"
+    case SortDesc:
+        return "▼"
+    }
+    return ""
+}
+
+// CompareText orders cells alphabetically, ignoring case
+func CompareText(a, b string) int {
+    return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// CompareNumeric orders cells by their numeric value. Cells that are not
+// numbers sort after those that are.
+func CompareNumeric(a, b string) int {
+    return compareParsed(a, b, func(s string) (float64, error) {
+        return strconv.ParseFloat(strings.TrimSpace(s), 64)
+    })
+}
+
+// CompareSize orders byte sizes such as "45KB" and "1.2GB" by magnitude
+func CompareSize(a, b string) int {
+    return compareParsed(a, b, func(s string) (float64, error) {
+        n, err := atoms.ParseBytes(s)
+        return float64(n), err
+    })
+}
+
+// CompareDate orders cells holding dates in the given time layout
+func CompareDate(layout string) Comparator {
+    return func(a, b string) int {
+        return compareParsed(a, b, func(s string) (float64, error) {
+            t, err := time.Parse(layout, strings.TrimSpace(s))
+            return float64(t.UnixNano()), err
+        })
+    }
+}
+
+// compareParsed orders by parse, putting unparsable cells last in text order
+func compareParsed(a, b string, parse func(string) (float64, error)) int {
+    x, errA := parse(a)
+    y, errB := parse(b)
+    switch {
+    case errA != nil && errB != nil:
+        return CompareText(a, b)
+    case errA != nil:
+        return 1
+    case errB != nil:
+        return -1
+    case x < y:
+        return -1
+    case x > y:
+        return 1
+    }
+    return 0
+}
+
+// DataTable is a scrollable table whose columns sort by keyboard or by
+// clicking their headers. < and > pick the column, s cycles its sort and
+// / filters the rows, e exports them and enter edits an Editable cell or,
+// with SetDetail, expands the row; c opens the column manager and
+// shift+←/→ moves a column. Grouped rows fold with space, and gg, G, 42G
+// and ctrl+d/u move as in vim. With a page size set it pages instead of
+// scrolling. Rows are either held in full (SetRows) or fetched from a
+// DataSource; RowUpsertMsg and RowDeleteMsg patch them in place.
+type DataTable struct {
+    columns      []Column
+    rows         []Row
+    view         []int // Indices into rows, in display order
+    keyCol       int   // Column identifying rows for live updates
+    cursor       int   // Position in view
+    offset       int   // First visible position in view
+    height       int   // Lines including the header
+    col          int   // Column picked for keyboard sorting
+    sortCol      int
+    sortDir      SortDir
+    source       DataSource // Rows come from here rather than SetRows when set
+    total        int        // Rows the source reported across all pages
+    loading      bool
+    loadErr      error
+    seq          int // Numbers fetches so stale results are dropped
+    cancel       context.CancelFunc
+    placeholders map[TableState]PlaceholderFunc // Overrides of the empty, loading and error views
+    filter       textinput.Model
+    filtering    bool // Filter input has the keyboard
+    terms        []filterTerm
+    order        []int  // Column indices in display order
+    userHidden   []bool // Columns switched off in the column manager
+    managing     bool   // Column manager popup is open
+    manageCursor int    // Position in order highlighted in the popup
+    store        state.Store
+    storeKey     string
+    saveFailed   bool        // A layout save failed and was reported
+    edit         *cellEditor // Open cell editor, nil when none
+    exporting    bool        // Waiting for the export format key
+    exportName   string
+    detail       DetailFunc
+    expanded     map[int]bool // Rows showing their detail pane, by index into rows
+    grouped      bool
+    groupCol     int
+    collapsed    map[string]bool // Folded groups, by value
+    spans        []groupSpan
+    spanAt       []int // Group of each position in view
+    pager        molecules.Paginator
+    paged        bool
+    widths       []int // Laid-out column widths, 0 for hidden columns
+    width        int   // Width to lay out across, 0 for natural widths
+    inset        int
+    fit          bool // Follow the terminal width
+    hscroll      bool // Scroll columns sideways rather than fit them
+    frozen       int  // Leading columns that never scroll away
+    hoff         int  // First scrolled column drawn after the frozen ones
+    rowStyle     RowStyleFunc
+    zebra        bool
+    nav          VimNav
+    focused      bool
+    styles       table.Styles
+    id           string
+}
+
+// NewDataTable returns the scripture registry demo table
+func NewDataTable() DataTable {
+    columns := []Column{
+        {Title: "ID", Width: 5, Compare: CompareNumeric, Priority: 2, Aggregate: AggregateCount},
+        {Title: "Scripture", Width: 20, Flex: 1, MinWidth: 12, MaxWidth: 48, Priority: 3,
+            Editable: true, Validators: []molecules.Validator{molecules.Required()}},
+        {Title: "Status", Width: 10, Priority: 1, Render: BadgeCell(map[string]atoms.BadgeVariant{
+            "Active":  atoms.BadgeSuccess,
+            "Dormant": atoms.BadgeWarning,
+        }), Editable: true, Options: []string{"Active", "Dormant"}},
+        {Title: "Size", Width: 10, Compare: CompareSize, Render: RightAlignCell, Aggregate: AggregateSize},
+    }
+
+    rows := []Row{
+        {"1", "genesis.py", "Active", atoms.FormatBytes(12 << 10)},
+        {"2", "weaver.go", "Active", atoms.FormatBytes(45 << 10)},
+        {"3", "void.rs", "Dormant", atoms.FormatBytes(0)},
+        {"4", "prophet.ts", "Active", atoms.FormatBytes(18 << 10)},
+    }
+
+    t := NewTable(columns, rows)
+    t.SetHeight(11)
+    t.SetExportName("scriptures")
+    t.SetDetail(t.JSONDetail())
+    t.GroupBy(2)
+    t.SetZebra(true)
+    t.SetRowStyle(func(r Row) lipgloss.Style {
+        if len(r) > 2 && r[2] == "Dormant" {
+            return lipgloss.NewStyle().Foreground(theme.Subtext)
+        }
+        return lipgloss.NewStyle()
+    })
+    t.Focus()
+    return t
+}
+
+// NewTable builds a table over rows, which it keeps in the given order until sorted
+func NewTable(columns []Column, rows []Row) DataTable {
+    t := DataTable{
+        columns:    columns,
+        height:     len(rows) + 2,
+        filter:     newFilterInput(),
+        exportName: "table",
+        styles:     tableStyles(),
+        id:         zone.NewID("table"),
+    }
+    if t.aggregated() {
+        t.height += 2
+    }
+    t.SetLayout(ColumnLayout{})
+    t.SetRows(rows)
+    return t
+}
+
+func (t *DataTable) Focus() {
+    t.focused = true
+}
+
+func (t *DataTable) Blur() {
+    t.focused = false
+}
+
+func (t DataTable) Focused() bool {
+    return t.focused
+}
+
+// Capturing reports whether the table is taking text or a follow-up key,
+// so the app can hold back its own single-letter shortcuts
+func (t DataTable) Capturing() bool {
+    return t.filtering || t.exporting || t.managing || t.edit != nil
+}
+
+// SetHeight sets the height of the table in lines, header included
+func (t *DataTable) SetHeight(h int) {
+    t.height = max(h, 3)
+    t.scroll()
+}
+
+// SetPageSize splits the rows into pages of n, drawn n lines tall with a page
+// indicator in the footer. Zero goes back to one scrolling list.
+func (t *DataTable) SetPageSize(n int) {
+    t.paged = n > 0
+    t.pager = molecules.NewPaginator(n)
+    t.scroll()
+}
+
+// Page returns the zero-based current page and the page count
+func (t DataTable) Page() (page, total int) {
+    return t.pager.Page(), t.pager.Total()
+}
+
+// SetPage jumps to the first row of page i; the command reports the move
+func (t *DataTable) SetPage(i int) tea.Cmd {
+    if !t.paged {
+        return nil
+    }
+    cmd := t.pager.SetPage(i)
+    t.cursor = clamp(t.pager.Page()*t.pager.PerPage, 0, len(t.view)-1)
+    if t.source != nil {
+        t.cursor = 0 // The new page's rows replace the held ones
+    }
+    t.scroll()
+    return cmd
+}
+
+// SetRows replaces the data, re-applying the current sort and filter
+func (t *DataTable) SetRows(rows []Row) {
+    t.rows = rows
+    t.view = nil
+    t.expanded = nil
+    t.layout()
+    t.rebuild()
+}
+
+// rebuild recomputes the visible rows from the filter and sort, keeping the
+// cursor on the same row when it is still shown
+func (t *DataTable) rebuild() {
+    selected := -1
+    if len(t.view) > 0 {
+        selected = t.view[t.cursor]
+    }
+
+    view := make([]int, 0, len(t.rows))
+    for i := range t.rows {
+        if t.source != nil || t.matches(i) {
+            view = append(view, i)
+        }
+    }
+    t.sort(view)
+    t.group(view)
+    t.view = view
+
+    for i, r := range t.view {
+        if r == selected {
+            t.cursor = i
+        }
+    }
+    t.cursor = clamp(t.cursor, 0, len(t.view)-1)
+    t.scroll()
+}
+
+// Rows returns the rows in display order
+func (t DataTable) Rows() []Row {
+    out := make([]Row, len(t.view))
+    for i, r := range t.view {
+        out[i] = t.rows[r]
+    }
+    return out
+}
+
+// SelectedRow returns the row under the cursor, or nil for an empty table
+func (t DataTable) SelectedRow() Row {
+    if len(t.view) == 0 {
+        return nil
+    }
+    return t.rows[t.view[t.cursor]]
+}
+
+func (t DataTable) Cursor() int {
+    return t.cursor
+}
+
+// SetCursor moves the cursor to a display position, scrolling it into view
+func (t *DataTable) SetCursor(n int) {
+    t.cursor = clamp(n, 0, len(t.view)-1)
+    if s := t.groupOf(t.cursor); s != nil && t.collapsed[s.key] {
+        t.cursor = s.start // A folded group is reached through its header
+    }
+    t.scroll()
+}
+
+// Sort reports the sorted column and direction; dir is SortNone when unsorted
+func (t DataTable) Sort() (col int, dir SortDir) {
+    return t.sortCol, t.sortDir
+}
+
+// SortBy sorts on a column, keeping the cursor on the same row.
+// SortNone restores the original row order.
+func (t *DataTable) SortBy(col int, dir SortDir) {
+    if col < 0 || col >= len(t.columns) {
+        return
+    }
+    t.sortCol, t.sortDir = col, dir
+    t.rebuild()
+}
+
+// sort orders view, which the caller owns, by the sort column; ties keep
+// their original order
+func (t *DataTable) sort(view []int) {
+    if t.source != nil || t.sortDir == SortNone || t.sortCol >= len(t.columns) {
+        return // A DataSource sorts for itself
+    }
+    cmp := t.columns[t.sortCol].Compare
+    if cmp == nil {
+        cmp = CompareText
+    }
+
+    sort.SliceStable(view, func(i, j int) bool {
+        c := cmp(t.cell(view[i], t.sortCol), t.cell(view[j], t.sortCol))
+        if t.sortDir == SortDesc {
+            return c > 0
+        }
+        return c < 0
+    })
+}
+
+func (t DataTable) cell(row, col int) string {
+    if col < len(t.rows[row]) {
+        return t.rows[row][col]
+    }
+    return ""
+}
+
+// bodyHeight is the number of row lines left under the header, aggregates
+// and footer, or the page size when paged
+func (t DataTable) bodyHeight() int {
+    if t.paged {
+        return t.pager.PerPage
+    }
+    h := t.height - 2
+    if t.aggregated() {
+        h -= 2
+    }
+    if t.footerView() != "" {
+        h--
+    }
+    return max(h, 1)
+}
+
+// scroll moves the window of visible rows so the cursor stays on screen.
+// Paged tables show the whole page holding the cursor.
+func (t *DataTable) scroll() {
+    h := t.bodyHeight()
+    if t.paged && t.source != nil {
+        // Only the current page is held, so the cursor stays within it
+        t.pager.SetItems(t.total)
+        t.offset = 0
+        return
+    }
+    if t.paged {
+        t.pager.SetItems(len(t.view))
+        t.pager.SetPage(t.cursor / h)
+        t.offset = t.pager.Page() * h
+        return
+    }
+    if t.cursor < t.offset {
+        t.offset = t.cursor
+    }
+    if t.cursor >= t.offset+h {
+        t.offset = t.cursor - h + 1
+    }
+    t.offset = clamp(t.offset, 0, max(len(t.view)-h, 0))
+}
+
+func (t DataTable) headerZone(col int) string {
+    return t.id + ":h" + strconv.Itoa(col)
+}
+
+func (t DataTable) Update(msg tea.Msg) (DataTable, tea.Cmd) {
+    before := t.query()
+    var cmd tea.Cmd
+    t, cmd = t.update(msg)
+    t.scrollColumns()
+    if t.source != nil && t.query() != before {
+        cmd = tea.Batch(cmd, t.Refresh())
+    }
+    return t, cmd
+}
+
+func (t DataTable) update(msg tea.Msg) (DataTable, tea.Cmd) {
+    switch msg := msg.(type) {
+    case tableFetchedMsg:
+        t.fetched(msg)
+    case RowUpsertMsg:
+        t.UpsertRow(msg.Row)
+    case RowDeleteMsg:
+        t.DeleteRow(msg.ID)
+    case atoms.SkeletonTickMsg:
+        if t.loading {
+            return t, atoms.SkeletonTick()
+        }
+    case tea.KeyMsg:
+        switch {
+        case !t.focused:
+            return t, nil
+        case t.edit != nil:
+            return t.updateEdit(msg)
+        case t.managing:
+            return t.updateManager(msg)
+        case t.filtering:
+            return t.updateFilter(msg)
+        case t.exporting:
+            return t.updateExport(msg)
+        case msg.String() == "/":
+            t.filtering = true
+            t.scroll()
+            return t, t.filter.Focus()
+        case msg.String() == "e":
+            t.exporting = true
+            t.scroll()
+            return t, nil
+        case msg.String() == "enter" && t.folded(t.cursor):
+            t.ToggleGroup(t.groupOf(t.cursor).key)
+            return t, nil
+        case msg.String() == "enter" && t.col < len(t.columns) && t.columns[t.col].Editable:
+            return t, t.EditCell(t.col)
+        case msg.String() == "enter" && t.detail != nil:
+            t.ToggleDetail(t.cursor)
+            return t, nil
+        }
+        return t.updateRows(msg)
+    case tea.MouseMsg:
+        return t.updateMouse(msg)
+    case tea.WindowSizeMsg:
+        t.resize(msg)
+    }
+    return t, nil
+}
+
+// updateRows handles the navigation and sorting keys
+func (t DataTable) updateRows(msg tea.KeyMsg) (DataTable, tea.Cmd) {
+    if to, ok := t.nav.Move(msg, t.cursor, len(t.view), t.bodyHeight()); ok {
+        t.SetCursor(to)
+        return t, nil
+    }
+    switch msg.String() {
+    case "up", "k":
+        t.moveCursor(-1)
+    case "down", "j":
+        t.moveCursor(1)
+    case "home":
+        t.SetCursor(0)
+    case "end":
+        t.SetCursor(len(t.view) - 1)
+    case "pgup":
+        if t.paged {
+            return t, t.SetPage(t.pager.Page() - 1)
+        }
+        t.moveCursor(-t.bodyHeight())
+    case "pgdown":
+        if t.paged {
+            return t, t.SetPage(t.pager.Page() + 1)
+        }
+        t.moveCursor(t.bodyHeight())
+    case " ":
+        if s := t.groupOf(t.cursor); s != nil {
+            t.ToggleGroup(s.key)
+        }
+    case "<":
+        t.col = t.nextColumn(t.col, -1)
+    case ">":
+        t.col = t.nextColumn(t.col, 1)
+    case "shift+left":
+        t.MoveColumn(t.col, -1)
+        cmd := t.saveLayout()
+        return t, cmd
+    case "shift+right":
+        t.MoveColumn(t.col, 1)
+        cmd := t.saveLayout()
+        return t, cmd
+    case "c":
+        t.managing = true
+        t.manageCursor = max(t.position(t.col), 0)
+    case "s":
+        t.cycleSort(t.col)
+    case "r":
+        return t, t.Refresh()
+    }
+    return t, nil
+}
+
+// updateMouse scrolls with the wheel, sorts on header clicks and selects the clicked row
+func (t DataTable) updateMouse(msg tea.MouseMsg) (DataTable, tea.Cmd) {
+    if msg.Action != tea.MouseActionPress {
+        return t, nil
+    }
+    switch msg.Button {
+    case tea.MouseButtonWheelUp:
+        if zone.Get(t.id).InBounds(msg) {
+            t.moveCursor(-1)
+        }
+    case tea.MouseButtonWheelDown:
+        if zone.Get(t.id).InBounds(msg) {
+            t.moveCursor(1)
+        }
+    case tea.MouseButtonLeft:
+        for i := range t.columns {
+            if zone.Get(t.headerZone(i)).InBounds(msg) {
+                t.col = i
+                t.cycleSort(i)
+                return t, nil
+            }
+        }
+        for i, s := range t.spans {
+            if zone.Get(t.groupZone(i)).InBounds(msg) {
+                t.SetCursor(s.start)
+                t.ToggleGroup(s.key)
+                return t, nil
+            }
+        }
+        if body := zone.Get(t.id + ":body"); body.InBounds(msg) {
+            _, y := body.Pos(msg)
+            _, rowAt := t.bodyLines(lipgloss.Width(t.headerView()), t.bodyHeight())
+            if y >= 0 && y < len(rowAt) && rowAt[y] >= 0 {
+                t.SetCursor(rowAt[y])
+            }
+        }
+    }
+    return t, nil
+}
+
+// nextColumn steps from col in dir through the display order, skipping hidden columns
+func (t DataTable) nextColumn(col, dir int) int {
+    for p := t.position(col) + dir; p >= 0 && p < len(t.order); p += dir {
+        if !t.ColumnHidden(t.order[p]) {
+            return t.order[p]
+        }
+    }
+    return col
+}
+
+// cycleSort advances a column through ascending, descending and unsorted;
+// picking a different column starts it ascending
+func (t *DataTable) cycleSort(col int) {
+    dir := SortAsc
+    if col == t.sortCol {
+        dir = t.sortDir.next()
+    }
+    t.SortBy(col, dir)
+}
+
+// Overlay draws the table's floating parts, the column manager and the
+// option list of an enum cell editor, over a frame that has already been
+// through zone.Scan
+func (t DataTable) Overlay(screen string) string {
+    return t.managerOverlay(t.editOverlay(screen))
+}
+
+func (t DataTable) View() string {
+    lines := []string{t.headerView()}
+
+    width := lipgloss.Width(lines[0])
+    body, placeholder := t.placeholderView(width)
+    if !placeholder {
+        body, _ = t.bodyLines(width, t.bodyHeight())
+    }
+    lines = append(lines, zone.Mark(t.id+":body", strings.Join(body, "\n")))
+    if agg := t.aggregateView(); agg != "" {
+        lines = append(lines, agg)
+    }
+    if footer := t.footerView(); footer != "" {
+        lines = append(lines, footer)
+    }
+
+    return zone.Mark(t.id, strings.Join(lines, "\n"))
+}
+
+// bodyLines lays out the visible rows, with their group headers and detail
+// panes, in h lines. rowAt maps each line to the display position it belongs
+// to, -1 for padding. When the extra lines above it would push the cursor
+// out of sight, leading rows are skipped until it fits.
+func (t DataTable) bodyLines(width, h int) (lines []string, rowAt []int) {
+    start := t.offset
+    for start < t.cursor && t.linesBetween(start, t.cursor, width) > h {
+        start++
     }
 
-    rows := []table.Row{
-        {"1", "genesis.py", "Active", "12KB"},
-        {"2", "weaver.go", "Active", "45KB"},
-        {"3", "void.rs", "Dormant", "0KB"},
-        {"4", "prophet.ts", "Active", "18KB"},
+    for pos := start; pos < len(t.view) && len(lines) < h; pos++ {
+        if t.paged && pos >= t.offset+t.pager.PerPage {
+            break
+        }
+        for _, l := range t.posLines(pos, width) {
+            lines = append(lines, l)
+            rowAt = append(rowAt, pos)
+        }
     }
+    if len(lines) > h {
+        lines, rowAt = lines[:h], rowAt[:h]
+    }
+    for len(lines) < h {
+        lines = append(lines, strings.Repeat(" ", width))
+        rowAt = append(rowAt, -1)
+    }
+    return lines, rowAt
+}
+
+// linesBetween counts the body lines from position from down to the line
+// of position to
+func (t DataTable) linesBetween(from, to, width int) int {
+    n := 0
+    for pos := from; pos < to; pos++ {
+        n += len(t.posLines(pos, width))
+    }
+    if s := t.groupOf(to); s != nil && s.start == to && !t.collapsed[s.key] {
+        n++ // The header above the row
+    }
+    return n + 1
+}
+
+func (t DataTable) headerView() string {
+    var cells []string
+    for _, i := range t.drawnColumns() {
+        c, w := t.columns[i], t.widths[i]
+        title := c.Title
+        if t.sortDir != SortNone && i == t.sortCol {
+            title = atoms.Truncate(title, w-2, "…") + " " + t.sortDir.glyph()
+        }
+
+        style := t.styles.Header.Copy()
+        if t.focused && i == t.col {
+            style = style.Underline(true)
+        }
+        cells = append(cells, zone.Mark(t.headerZone(i), style.Render(fitCell(title, w))))
+    }
+    return lipgloss.JoinHorizontal(lipgloss.Top, cells...)
+}
 
-    t := table.New(
-        table.WithColumns(columns),
-        table.WithRows(rows),
-        table.WithFocused(true),
-        table.WithHeight(7),
-    )
+func (t DataTable) rowView(pos int) string {
+    style := t.rowStyleAt(pos)
+
+    row := t.view[pos]
+    var b strings.Builder
+    for _, i := range t.drawnColumns() {
+        switch w := t.widths[i]; {
+        case t.edit != nil && t.edit.row == row && t.edit.col == i:
+            b.WriteString(t.editView(style, w))
+        case t.columns[i].Render != nil:
+            b.WriteString(paintRendered(t.columns[i].Render(t.cell(row, i), w), style, w))
+        default:
+            b.WriteString(paintCell(t.cell(row, i), t.highlights(row, i), style, w))
+        }
+    }
+    return b.String()
+}
+
+// footerView is the line under the rows, empty when there is nothing to report
+func (t DataTable) footerView() string {
+    if t.exporting {
+        return t.exportView()
+    }
+    if f := t.editFooter(); f != "" {
+        return f
+    }
+    var parts []string
+    if t.paged {
+        parts = append(parts, t.pager.View())
+    }
+    if h := t.hscrollView(); h != "" {
+        parts = append(parts, h)
+    }
+    if f := t.filterView(); f != "" {
+        parts = append(parts, f)
+    }
+    return strings.Join(parts, theme.Caption.Render(" • "))
+}
 
+// selectedStyle is the cell style with the selection colors on top, so the
+// highlight runs unbroken across the cell padding
+func selectedStyle(s table.Styles) lipgloss.Style {
+    return s.Selected.Copy().Inherit(s.Cell).
+        PaddingLeft(s.Cell.GetPaddingLeft()).
+        PaddingRight(s.Cell.GetPaddingRight())
+}
+
+// paintCell renders a plain cell in style, picking out the runes flagged in
+// marks. Each run is styled on its own so a highlight never cuts off the row
+// background behind it.
+func paintCell(s string, marks []bool, style lipgloss.Style, width int) string {
+    if marks == nil {
+        return style.Render(fitCell(s, width))
+    }
+
+    inline := style.Copy().UnsetPadding()
+    hit := inline.Copy().Foreground(theme.Warning).Bold(true).Underline(true)
+    pad := func(n int) string { return inline.Render(strings.Repeat(" ", n)) }
+
+    fitted := []rune(atoms.Truncate(s, width, "…"))
+    kept := len(fitted)
+    if kept < len([]rune(s)) {
+        kept-- // The ellipsis is not part of the match
+    }
+
+    var b strings.Builder
+    b.WriteString(pad(style.GetPaddingLeft()))
+    for start := 0; start < len(fitted); {
+        on := start < kept && start < len(marks) && marks[start]
+        end := start + 1
+        for end < len(fitted) && (end < kept && end < len(marks) && marks[end]) == on {
+            end++
+        }
+        if on {
+            b.WriteString(hit.Render(string(fitted[start:end])))
+        } else {
+            b.WriteString(inline.Render(string(fitted[start:end])))
+        }
+        start = end
+    }
+    b.WriteString(pad(width - lipgloss.Width(string(fitted)) + style.GetPaddingRight()))
+    return b.String()
+}
+
+// fitCell truncates or pads s to exactly width cells so wide runes keep the columns aligned
+func fitCell(s string, width int) string {
+    s = atoms.Truncate(s, width, "…")
+    return s + strings.Repeat(" ", max(width-lipgloss.Width(s), 0))
+}
+
+// tableStyles themes the table header, cells and selection
+func tableStyles() table.Styles {
     s := table.DefaultStyles()
-    s.Header = s.Header.
+    s.Header = theme.Derive(theme.Inherit(theme.Label, s.Header.
         BorderStyle(lipgloss.NormalBorder()).
         BorderForeground(theme.Border).
-        BorderBottom(true).
-        Bold(true)
+        BorderBottom(true)))
+    s.Cell = theme.Derive(s.Cell)
 
     s.Selected = s.Selected.
         Foreground(lipgloss.Color("229")).
         Background(theme.Primary).
         Bold(false)
 
-    t.SetStyles(s)
-    return t
-}
\ No newline at end of file
+    return s
+}
+
+// cloneMap copies m ahead of a change to it, so tables sharing the map
+// through a value copy stay independent; nil gives an empty map
+func cloneMap[K comparable, V any](m map[K]V) map[K]V {
+    if m == nil {
+        return map[K]V{}
+    }
+    return maps.Clone(m)
+}
+
+func clamp(v, lo, hi int) int {
+    if v > hi {
+        v = hi
+    }
+    if v < lo {
+        v = lo
+    }
+    return v
+}
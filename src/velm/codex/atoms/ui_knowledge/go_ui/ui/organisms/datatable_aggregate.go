@@ -0,0 +1,105 @@
+package organisms
+
+import (
+    "math"
+    "strconv"
+    "strings"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+)
+
+// Aggregator sums up a column for the footer row from the values of the rows
+// the filter left, in display order
+type Aggregator func(values []string) string
+
+// AggregateSum adds up the cells that are numbers
+func AggregateSum(values []string) string {
+    sum, _ := sumParsed(values, parseNumber)
+    return formatNumber(sum)
+}
+
+// AggregateAvg is the mean of the cells that are numbers
+func AggregateAvg(values []string) string {
+    sum, n := sumParsed(values, parseNumber)
+    if n == 0 {
+        return ""
+    }
+    return formatNumber(sum / float64(n))
+}
+
+// AggregateCount counts the cells that are not empty
+func AggregateCount(values []string) string {
+    n := 0
+    for _, v := range values {
+        if strings.TrimSpace(v) != "" {
+            n++
+        }
+    }
+    return strconv.Itoa(n)
+}
+
+// AggregateSize totals byte sizes such as "45KB", formatted the same way
+func AggregateSize(values []string) string {
+    sum, _ := sumParsed(values, func(s string) (float64, error) {
+        n, err := atoms.ParseBytes(s)
+        return float64(n), err
+    })
+    return atoms.FormatBytes(int64(sum))
+}
+
+// sumParsed adds up the values parse accepts and counts them
+func sumParsed(values []string, parse func(string) (float64, error)) (float64, int) {
+    sum, n := 0.0, 0
+    for _, v := range values {
+        if x, err := parse(v); err == nil {
+            sum += x
+            n++
+        }
+    }
+    return sum, n
+}
+
+func parseNumber(s string) (float64, error) {
+    return strconv.ParseFloat(strings.TrimSpace(s), 64)
+}
+
+// formatNumber prints at most two decimals and no trailing zeros
+func formatNumber(v float64) string {
+    return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
+
+// aggregated reports whether any column has a footer aggregate
+func (t DataTable) aggregated() bool {
+    for _, c := range t.columns {
+        if c.Aggregate != nil {
+            return true
+        }
+    }
+    return false
+}
+
+// aggregateView is the footer row of column aggregates over the filtered
+// rows, flush right like figures, or "" when no column has one. With a
+// DataSource it covers the fetched page only.
+func (t DataTable) aggregateView() string {
+    if !t.aggregated() {
+        return ""
+    }
+    style := t.styles.Header.Copy().BorderBottom(false).BorderTop(true)
+
+    var cells []string
+    for _, i := range t.drawnColumns() {
+        value := ""
+        if agg := t.columns[i].Aggregate; agg != nil {
+            values := make([]string, len(t.view))
+            for p, row := range t.view {
+                values[p] = t.cell(row, i)
+            }
+            value = agg(values)
+        }
+        w := t.widths[i]
+        cells = append(cells, style.Render(RightAlignCell(atoms.Truncate(value, w, "…"), w)))
+    }
+    return lipgloss.JoinHorizontal(lipgloss.Top, cells...)
+}
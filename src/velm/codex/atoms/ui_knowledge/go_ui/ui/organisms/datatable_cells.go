@@ -0,0 +1,95 @@
+package organisms
+
+import (
+    "fmt"
+    "strconv"
+    "strings"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+// CellRenderer draws a cell value within width cells. Its output may carry
+// styles; the table truncates and pads it to the column either way. Sorting
+// and filtering keep working on the raw value.
+type CellRenderer func(value string, width int) string
+
+// BadgeCell shows values as badges colored by variants, e.g.
+// {"Active": atoms.BadgeSuccess, "Failed": atoms.BadgeDanger}. Unlisted
+// values stay plain text.
+func BadgeCell(variants map[string]atoms.BadgeVariant) CellRenderer {
+    return func(value string, width int) string {
+        if v, ok := variants[value]; ok {
+            return atoms.Badge(value, v)
+        }
+        return value
+    }
+}
+
+// RightAlignCell right-aligns values, for columns of numbers
+func RightAlignCell(value string, width int) string {
+    return strings.Repeat(" ", max(width-lipgloss.Width(value), 0)) + value
+}
+
+// ProgressCell draws a numeric value out of total as a thin bar with its
+// percentage. Values that are not numbers stay plain text.
+func ProgressCell(total float64) CellRenderer {
+    return func(value string, width int) string {
+        v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+        if err != nil || total <= 0 {
+            return value
+        }
+        ratio := clampRatio(v / total)
+
+        label := fmt.Sprintf(" %3.0f%%", ratio*100)
+        bar := width - len(label)
+        if bar < 2 {
+            return strings.TrimSpace(label)
+        }
+        filled := int(ratio*float64(bar) + 0.5)
+        return lipgloss.NewStyle().Foreground(theme.Accent).Render(strings.Repeat("━", filled)) +
+            lipgloss.NewStyle().Foreground(theme.Border).Render(strings.Repeat("━", bar-filled)) +
+            theme.Caption.Render(label)
+    }
+}
+
+// SparklineCell draws a comma-separated series such as "3,5,2,8" as a
+// sparkline of its latest values
+func SparklineCell(value string, width int) string {
+    var values []float64
+    for _, f := range strings.Split(value, ",") {
+        v, err := strconv.ParseFloat(strings.TrimSpace(f), 64)
+        if err != nil {
+            return value
+        }
+        values = append(values, v)
+    }
+    return atoms.Sparkline(values, width)
+}
+
+func clampRatio(r float64) float64 {
+    if r < 0 {
+        return 0
+    }
+    if r > 1 {
+        return 1
+    }
+    return r
+}
+
+// paintRendered fits styled renderer output into a cell. Every reset inside
+// it is followed by the row style again, keeping the row background and the
+// selection highlight continuous around badges and colored glyphs.
+func paintRendered(content string, style lipgloss.Style, width int) string {
+    inline := style.Copy().UnsetPadding()
+    content = atoms.Truncate(content, width, "…")
+    fill := strings.Repeat(" ", max(width-lipgloss.Width(content), 0))
+
+    if prefix, _, ok := strings.Cut(inline.Render("x"), "x"); ok && prefix != "" {
+        content = strings.ReplaceAll(content, "\x1b[0m", "\x1b[0m"+prefix)
+    }
+    return inline.Render(strings.Repeat(" ", style.GetPaddingLeft())) +
+        inline.Render(content) +
+        inline.Render(fill+strings.Repeat(" ", style.GetPaddingRight()))
+}
@@ -0,0 +1,220 @@
+package organisms
+
+import (
+    "errors"
+    "strings"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/state"
+    "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
+)
+
+// ColumnLayout is the user's column arrangement, by title so it survives
+// columns being added or removed between runs
+type ColumnLayout struct {
+    Order  []string `json:"order"`
+    Hidden []string `json:"hidden,omitempty"`
+}
+
+// Layout returns the current column order and the columns the user hid
+func (t DataTable) Layout() ColumnLayout {
+    var l ColumnLayout
+    for _, i := range t.order {
+        l.Order = append(l.Order, t.columns[i].Title)
+        if t.userHidden[i] {
+            l.Hidden = append(l.Hidden, t.columns[i].Title)
+        }
+    }
+    return l
+}
+
+// SetLayout applies a saved arrangement. Unknown titles are skipped and
+// columns the layout does not mention keep their place at the end.
+func (t *DataTable) SetLayout(l ColumnLayout) {
+    index := map[string]int{}
+    for i, c := range t.columns {
+        index[c.Title] = i
+    }
+
+    placed := make([]bool, len(t.columns))
+    var order []int
+    for _, title := range l.Order {
+        if i, ok := index[title]; ok && !placed[i] {
+            order = append(order, i)
+            placed[i] = true
+        }
+    }
+    for i := range t.columns {
+        if !placed[i] {
+            order = append(order, i)
+        }
+    }
+    t.order = order
+
+    t.userHidden = make([]bool, len(t.columns))
+    for _, title := range l.Hidden {
+        if i, ok := index[title]; ok {
+            t.userHidden[i] = true
+        }
+    }
+    t.layout()
+}
+
+// PersistLayout restores the layout saved under key and saves every later
+// change back to store
+func (t *DataTable) PersistLayout(store state.Store, key string) error {
+    t.store, t.storeKey = store, key
+    var l ColumnLayout
+    switch err := store.Load(key, &l); {
+    case errors.Is(err, state.ErrNotFound):
+        return nil
+    case err != nil:
+        return err
+    }
+    t.SetLayout(l)
+    return nil
+}
+
+// TableLayoutErrMsg reports that a column layout could not be saved. It is
+// sent once, on the first failure, so a broken store does not repeat it on
+// every change.
+type TableLayoutErrMsg struct {
+    Err error
+}
+
+// saveLayout writes the layout straight away, so quick changes are saved in
+// order; the file is small enough not to hold up the update loop
+func (t *DataTable) saveLayout() tea.Cmd {
+    if t.store == nil {
+        return nil
+    }
+    err := t.store.Save(t.storeKey, t.Layout())
+    if err == nil || t.saveFailed {
+        return nil
+    }
+    t.saveFailed = true
+    return func() tea.Msg { return TableLayoutErrMsg{Err: err} }
+}
+
+// SetColumnVisible shows or hides column i; the last visible column stays
+func (t *DataTable) SetColumnVisible(i int, on bool) {
+    if i < 0 || i >= len(t.columns) {
+        return
+    }
+    if !on && t.visibleColumns() == 1 && !t.userHidden[i] {
+        return
+    }
+    t.userHidden[i] = !on
+    t.layout()
+    if !on && t.col == i {
+        t.col = t.nextColumn(i, 1)
+        if t.col == i {
+            t.col = t.nextColumn(i, -1)
+        }
+    }
+}
+
+// MoveColumn shifts column i by delta places in the display order
+func (t *DataTable) MoveColumn(i, delta int) {
+    pos := t.position(i)
+    to := clamp(pos+delta, 0, len(t.order)-1)
+    if pos < 0 || to == pos {
+        return
+    }
+    order := append([]int(nil), t.order...)
+    order = append(order[:pos], order[pos+1:]...)
+    order = append(order[:to], append([]int{i}, order[to:]...)...)
+    t.order = order
+}
+
+// position returns where column i sits in the display order
+func (t DataTable) position(i int) int {
+    for p, c := range t.order {
+        if c == i {
+            return p
+        }
+    }
+    return -1
+}
+
+func (t DataTable) visibleColumns() int {
+    n := 0
+    for _, h := range t.userHidden {
+        if !h {
+            n++
+        }
+    }
+    return n
+}
+
+// updateManager drives the column popup opened with c: space toggles the
+// highlighted column, shift+up/down moves it, esc or enter closes
+func (t DataTable) updateManager(msg tea.KeyMsg) (DataTable, tea.Cmd) {
+    col := t.order[t.manageCursor]
+    switch msg.String() {
+    case "up", "k":
+        t.manageCursor = max(t.manageCursor-1, 0)
+    case "down", "j":
+        t.manageCursor = min(t.manageCursor+1, len(t.order)-1)
+    case " ", "x":
+        t.SetColumnVisible(col, t.userHidden[col])
+        cmd := t.saveLayout()
+        return t, cmd
+    case "shift+up", "K":
+        t.MoveColumn(col, -1)
+        t.manageCursor = t.position(col)
+        cmd := t.saveLayout()
+        return t, cmd
+    case "shift+down", "J":
+        t.MoveColumn(col, 1)
+        t.manageCursor = t.position(col)
+        cmd := t.saveLayout()
+        return t, cmd
+    case "esc", "enter", "c", "q":
+        t.managing = false
+    }
+    return t, nil
+}
+
+// managerView renders the column popup, or "" while it is closed
+func (t DataTable) managerView() string {
+    if !t.managing {
+        return ""
+    }
+
+    width := 0
+    for _, c := range t.columns {
+        width = max(width, lipgloss.Width(c.Title))
+    }
+    width = min(max(width+6, 28), 40)
+
+    rows := []string{theme.Caption.Render(atoms.Truncate("space toggle • shift+↑↓ move", width, "…"))}
+    for p, i := range t.order {
+        box := atoms.NewCheckbox(atoms.Truncate(t.columns[i].Title, width-4, "…"))
+        if !t.userHidden[i] {
+            box.State = atoms.Checked
+        }
+        if p == t.manageCursor {
+            box.Focus()
+        }
+        rows = append(rows, lipgloss.NewStyle().Width(width).Render(box.View()))
+    }
+
+    return lipgloss.NewStyle().
+        Border(lipgloss.RoundedBorder()).
+        BorderForeground(theme.Primary).
+        Padding(0, 1).
+        Render(theme.Label.Render("Columns") + "\n" + strings.Join(rows, "\n"))
+}
+
+// managerOverlay floats the column popup over the top left of the table
+func (t DataTable) managerOverlay(screen string) string {
+    z := zone.Get(t.id)
+    if !t.managing || z.IsZero() {
+        return screen
+    }
+    return atoms.Overlay(screen, t.managerView(), z.StartX+2, z.StartY+1)
+}
@@ -0,0 +1,75 @@
+package organisms
+
+import (
+    "encoding/json"
+    "strings"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+// DetailFunc renders the detail pane of an expanded row within width cells.
+// It may return several lines.
+type DetailFunc func(row Row, width int) string
+
+// detailIndent is the gutter drawn before every detail line
+const detailIndent = 4
+
+// SetDetail makes rows expandable: enter on a column that is not Editable
+// toggles a pane under the row drawn by fn. nil turns expansion off.
+func (t *DataTable) SetDetail(fn DetailFunc) {
+    t.detail = fn
+    t.expanded = nil
+}
+
+// JSONDetail is a DetailFunc showing the whole record as an indented JSON
+// object keyed by column title, in column order
+func (t DataTable) JSONDetail() DetailFunc {
+    titles := t.titles()
+    return func(row Row, width int) string {
+        lines := []string{"{"}
+        for i, cell := range t.record(row) {
+            k, _ := json.Marshal(titles[i])
+            v, _ := json.Marshal(cell)
+            line := "  " + string(k) + ": " + string(v)
+            if i < len(titles)-1 {
+                line += ","
+            }
+            lines = append(lines, line)
+        }
+        return strings.Join(append(lines, "}"), "\n")
+    }
+}
+
+// Expanded reports whether the row at display position pos shows its detail
+func (t DataTable) Expanded(pos int) bool {
+    return pos >= 0 && pos < len(t.view) && t.expanded[t.view[pos]]
+}
+
+// ToggleDetail expands or collapses the row at display position pos
+func (t *DataTable) ToggleDetail(pos int) {
+    if t.detail == nil || pos < 0 || pos >= len(t.view) {
+        return
+    }
+    row := t.view[pos]
+    t.expanded = cloneMap(t.expanded)
+    t.expanded[row] = !t.expanded[row]
+}
+
+// detailLines renders the expanded pane of a source row, gutter included
+func (t DataTable) detailLines(row, width int) []string {
+    if t.detail == nil || !t.expanded[row] {
+        return nil
+    }
+    gutter := lipgloss.NewStyle().Foreground(theme.Border).Render("  │ ")
+    inner := max(width-detailIndent, 1)
+
+    text := t.detail(t.rows[row], inner)
+    lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+    for i, l := range lines {
+        l = atoms.Truncate(l, inner, "…")
+        lines[i] = gutter + l + strings.Repeat(" ", max(inner-lipgloss.Width(l), 0))
+    }
+    return lines
+}
@@ -0,0 +1,162 @@
+package organisms
+
+import (
+    "github.com/charmbracelet/bubbles/textinput"
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/molecules"
+    "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
+)
+
+// CellEditedMsg is emitted when an edited cell passes validation. Row indexes
+// the rows as given to SetRows (or fetched), not the sorted display order.
+type CellEditedMsg struct {
+    Row, Col int
+    Old, New string
+}
+
+// cellEditor is the in-place editor of one cell; choice is used instead of
+// input for columns with Options
+type cellEditor struct {
+    row, col int
+    input    textinput.Model
+    choice   molecules.Select
+    enum     bool
+    err      error
+}
+
+// Editing reports whether a cell editor is open
+func (t DataTable) Editing() bool {
+    return t.edit != nil
+}
+
+// EditCell opens the editor on the cell under the cursor in column col,
+// which must be Editable
+func (t *DataTable) EditCell(col int) tea.Cmd {
+    if len(t.view) == 0 || col < 0 || col >= len(t.columns) || !t.columns[col].Editable {
+        return nil
+    }
+    t.col = col
+    row := t.view[t.cursor]
+    value := t.cell(row, col)
+    ed := &cellEditor{row: row, col: col}
+
+    if opts := t.columns[col].Options; len(opts) > 0 {
+        ed.enum = true
+        ed.choice = molecules.NewSelect(opts...)
+        ed.choice.Width = max(t.widths[col]+cellPadding, 12)
+        for i, o := range opts {
+            if o == value {
+                ed.choice.SetSelected(i)
+            }
+        }
+        ed.choice.Focus()
+        ed.choice.Open()
+        t.edit = ed
+        return nil
+    }
+
+    ed.input = textinput.New()
+    ed.input.Prompt = ""
+    ed.input.TextStyle = lipgloss.NewStyle().Foreground(theme.Text)
+    ed.input.SetValue(value)
+    ed.input.CursorEnd()
+    t.edit = ed
+    return ed.input.Focus()
+}
+
+// updateEdit feeds a key to the open editor. Enter validates and commits,
+// esc abandons the edit.
+func (t DataTable) updateEdit(msg tea.KeyMsg) (DataTable, tea.Cmd) {
+    ed := *t.edit
+    t.edit = &ed
+
+    if ed.enum {
+        ed.choice, _ = ed.choice.Update(msg)
+        switch msg.String() {
+        case "enter":
+            return t, t.commitEdit(ed.choice.Value())
+        case "esc":
+            t.edit = nil
+        }
+        return t, nil
+    }
+
+    switch msg.String() {
+    case "enter":
+        value := ed.input.Value()
+        if err := molecules.Validate(value, t.columns[ed.col].Validators...); err != nil {
+            ed.err = err
+            return t, nil
+        }
+        return t, t.commitEdit(value)
+    case "esc":
+        t.edit = nil
+        return t, nil
+    }
+
+    var cmd tea.Cmd
+    ed.input, cmd = ed.input.Update(msg)
+    ed.err = nil
+    return t, cmd
+}
+
+// commitEdit stores the new value, copying the rows first so the slice the
+// app passed in is never written to, and reports the change
+func (t *DataTable) commitEdit(value string) tea.Cmd {
+    ed := t.edit
+    t.edit = nil
+    old := t.cell(ed.row, ed.col)
+    if value == old {
+        return nil
+    }
+
+    rows := append([]Row(nil), t.rows...)
+    r := make(Row, max(len(rows[ed.row]), ed.col+1))
+    copy(r, rows[ed.row])
+    r[ed.col] = value
+    rows[ed.row] = r
+    t.rows = rows
+    t.rebuild()
+
+    msg := CellEditedMsg{Row: ed.row, Col: ed.col, Old: old, New: value}
+    return func() tea.Msg { return msg }
+}
+
+// editView draws the open editor in place of its cell
+func (t DataTable) editView(style lipgloss.Style, width int) string {
+    ed := *t.edit
+    var content string
+    if ed.enum {
+        content = atoms.Truncate(ed.choice.Value(), width-2, "…") + " " +
+            lipgloss.NewStyle().Foreground(theme.Primary).Render("▴")
+    } else {
+        ed.input.Width = max(width-1, 1)
+        content = ed.input.View()
+    }
+
+    edit := style.Copy().Underline(ed.err == nil)
+    if ed.err != nil {
+        edit = edit.Foreground(theme.Danger)
+    }
+    return zone.Mark(t.id+":edit", paintRendered(content, edit, width))
+}
+
+// editFooter shows why the typed value was rejected
+func (t DataTable) editFooter() string {
+    if t.edit == nil || t.edit.err == nil {
+        return ""
+    }
+    return lipgloss.NewStyle().Foreground(theme.Danger).Render(atoms.Icon("cross") + " " + t.edit.err.Error())
+}
+
+// editOverlay draws the option list of an open enum editor below its cell
+func (t DataTable) editOverlay(screen string) string {
+    z := zone.Get(t.id + ":edit")
+    if t.edit == nil || !t.edit.enum || z.IsZero() {
+        return screen
+    }
+    return atoms.Overlay(screen, t.edit.choice.Menu(), z.StartX, z.EndY+1)
+}
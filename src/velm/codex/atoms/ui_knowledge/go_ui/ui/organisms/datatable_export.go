@@ -0,0 +1,150 @@
+package organisms
+
+import (
+    "bytes"
+    "encoding/csv"
+    "encoding/json"
+    "io"
+    "os"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+// ExportFormat picks where an export goes
+type ExportFormat int
+
+const (
+    ExportCSV       ExportFormat = iota // <name>.csv in the working directory
+    ExportJSON                          // <name>.json in the working directory
+    ExportClipboard                     // CSV on the clipboard, via OSC 52
+)
+
+// TableExportedMsg reports a finished export; Path is empty for the clipboard
+type TableExportedMsg struct {
+    Format ExportFormat
+    Path   string
+    Rows   int
+    Err    error
+}
+
+// SetExportName sets the file name, without extension, that exports write to
+func (t *DataTable) SetExportName(name string) {
+    t.exportName = name
+}
+
+// WriteCSV writes the rows as shown, filtered and sorted, with a header row
+func (t DataTable) WriteCSV(w io.Writer) error {
+    cw := csv.NewWriter(w)
+    cw.Write(t.titles())
+    for _, r := range t.Rows() {
+        cw.Write(t.record(r))
+    }
+    cw.Flush()
+    return cw.Error()
+}
+
+// WriteJSON writes the rows as shown as an array of objects keyed by column
+// title, with keys in column order
+func (t DataTable) WriteJSON(w io.Writer) error {
+    titles := t.titles()
+    keys := make([][]byte, len(titles))
+    for i, title := range titles {
+        keys[i], _ = json.Marshal(title)
+    }
+
+    var b bytes.Buffer
+    b.WriteString("[")
+    for i, r := range t.Rows() {
+        if i > 0 {
+            b.WriteString(",")
+        }
+        b.WriteString("\n  {")
+        for j, cell := range t.record(r) {
+            if j > 0 {
+                b.WriteString(", ")
+            }
+            v, _ := json.Marshal(cell)
+            b.Write(keys[j])
+            b.WriteString(": ")
+            b.Write(v)
+        }
+        b.WriteString("}")
+    }
+    b.WriteString("\n]\n")
+    _, err := w.Write(b.Bytes())
+    return err
+}
+
+func (t DataTable) titles() []string {
+    out := make([]string, len(t.columns))
+    for i, c := range t.columns {
+        out[i] = c.Title
+    }
+    return out
+}
+
+// record pads or trims a row to one value per column
+func (t DataTable) record(r Row) []string {
+    out := make([]string, len(t.columns))
+    copy(out, r)
+    return out
+}
+
+// Export writes the rows as shown in the given format off the update loop
+// and reports back with a TableExportedMsg
+func (t DataTable) Export(format ExportFormat) tea.Cmd {
+    n := len(t.view)
+    switch format {
+    case ExportClipboard:
+        var b bytes.Buffer
+        if err := t.WriteCSV(&b); err != nil {
+            return func() tea.Msg { return TableExportedMsg{Format: format, Err: err} }
+        }
+        return atoms.ClipboardExec(b.String(), func(err error) tea.Msg {
+            return TableExportedMsg{Format: format, Rows: n, Err: err}
+        })
+    }
+
+    write, path := t.WriteCSV, t.exportName+".csv"
+    if format == ExportJSON {
+        write, path = t.WriteJSON, t.exportName+".json"
+    }
+    var b bytes.Buffer
+    err := write(&b)
+    return func() tea.Msg {
+        if err == nil {
+            err = os.WriteFile(path, b.Bytes(), 0o644)
+        }
+        return TableExportedMsg{Format: format, Path: path, Rows: n, Err: err}
+    }
+}
+
+// updateExport takes the key after e: c, j or y picks the format, anything
+// else backs out
+func (t DataTable) updateExport(msg tea.KeyMsg) (DataTable, tea.Cmd) {
+    t.exporting = false
+    t.scroll()
+    switch msg.String() {
+    case "c":
+        return t, t.Export(ExportCSV)
+    case "j":
+        return t, t.Export(ExportJSON)
+    case "y":
+        return t, t.Export(ExportClipboard)
+    }
+    return t, nil
+}
+
+// exportView lists the export choices while e is pending
+func (t DataTable) exportView() string {
+    if !t.exporting {
+        return ""
+    }
+    return theme.Caption.Render("export ") +
+        atoms.Kbd("c") + theme.Caption.Render(" csv  ") +
+        atoms.Kbd("j") + theme.Caption.Render(" json  ") +
+        atoms.Kbd("y") + theme.Caption.Render(" copy  ") +
+        atoms.Kbd("esc") + theme.Caption.Render(" cancel")
+}
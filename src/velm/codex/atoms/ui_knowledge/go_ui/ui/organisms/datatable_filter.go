@@ -0,0 +1,179 @@
+package organisms
+
+import (
+    "strings"
+
+    "github.com/charmbracelet/bubbles/textinput"
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// filterTerm is one word of a filter query. "col:value" looks for value
+// inside the named column; a bare word fuzzy-matches any cell.
+type filterTerm struct {
+    col  int // -1 for any column
+    text string
+}
+
+// parseFilter splits a query into terms. A column is named by any prefix of
+// its title, so "stat:dorm" filters on Status; unknown names match as text.
+func parseFilter(query string, columns []Column) []filterTerm {
+    var terms []filterTerm
+    for _, word := range strings.Fields(strings.ToLower(query)) {
+        term := filterTerm{col: -1, text: word}
+        if name, value, ok := strings.Cut(word, ":"); ok && name != "" {
+            for i, c := range columns {
+                if strings.HasPrefix(strings.ToLower(c.Title), name) {
+                    term = filterTerm{col: i, text: value}
+                    break
+                }
+            }
+        }
+        if term.text != "" {
+            terms = append(terms, term)
+        }
+    }
+    return terms
+}
+
+// matches reports whether a row satisfies every term of the filter
+func (t DataTable) matches(row int) bool {
+    for _, term := range t.terms {
+        if term.col >= 0 {
+            if !strings.Contains(strings.ToLower(t.cell(row, term.col)), term.text) {
+                return false
+            }
+            continue
+        }
+
+        found := false
+        for col := range t.columns {
+            if fuzzyPositions(term.text, t.cell(row, col)) != nil {
+                found = true
+                break
+            }
+        }
+        if !found {
+            return false
+        }
+    }
+    return true
+}
+
+// highlights marks the runes of a cell matched by the filter, or nil
+func (t DataTable) highlights(row, col int) []bool {
+    if len(t.terms) == 0 {
+        return nil
+    }
+    text := t.cell(row, col)
+    low := []rune(strings.ToLower(text))
+    if len(low) != len([]rune(text)) {
+        return nil
+    }
+
+    var marks []bool
+    mark := func(positions []int) {
+        if marks == nil {
+            marks = make([]bool, len(low))
+        }
+        for _, p := range positions {
+            marks[p] = true
+        }
+    }
+    for _, term := range t.terms {
+        switch term.col {
+        case col:
+            if i := strings.Index(string(low), term.text); i >= 0 {
+                start := len([]rune(string(low)[:i]))
+                for p := start; p < start+len([]rune(term.text)); p++ {
+                    mark([]int{p})
+                }
+            }
+        case -1:
+            mark(fuzzyPositions(term.text, text))
+        }
+    }
+    return marks
+}
+
+// fuzzyPositions finds query as a subsequence of target, ignoring case, and
+// returns the rune positions it matched or nil
+func fuzzyPositions(query, target string) []int {
+    q := []rune(strings.ToLower(query))
+    var out []int
+    for i, r := range []rune(strings.ToLower(target)) {
+        if len(out) < len(q) && r == q[len(out)] {
+            out = append(out, i)
+        }
+    }
+    if len(out) < len(q) {
+        return nil
+    }
+    return out
+}
+
+func newFilterInput() textinput.Model {
+    in := textinput.New()
+    in.Prompt = "/"
+    in.Placeholder = "filter, or col:value"
+    in.PromptStyle = lipgloss.NewStyle().Foreground(theme.Primary)
+    in.TextStyle = lipgloss.NewStyle().Foreground(theme.Text)
+    in.PlaceholderStyle = lipgloss.NewStyle().Foreground(theme.Subtext)
+    return in
+}
+
+// Filtering reports whether the filter input has the keyboard
+func (t DataTable) Filtering() bool {
+    return t.filtering
+}
+
+// FilterValue returns the active filter query
+func (t DataTable) FilterValue() string {
+    return t.filter.Value()
+}
+
+// SetFilter applies a filter query without opening the input
+func (t *DataTable) SetFilter(query string) {
+    t.filter.SetValue(query)
+    t.terms = parseFilter(query, t.columns)
+    t.rebuild()
+}
+
+// updateFilter feeds a key to the open filter input. Enter keeps the filter
+// and returns to the rows; esc clears it.
+func (t DataTable) updateFilter(msg tea.KeyMsg) (DataTable, tea.Cmd) {
+    switch msg.String() {
+    case "enter":
+        t.filtering = false
+        t.filter.Blur()
+        return t, nil
+    case "esc":
+        t.filtering = false
+        t.filter.Blur()
+        t.SetFilter("")
+        return t, nil
+    case "up", "down":
+        return t.updateRows(msg)
+    }
+
+    var cmd tea.Cmd
+    before := t.filter.Value()
+    t.filter, cmd = t.filter.Update(msg)
+    if t.filter.Value() != before {
+        t.SetFilter(t.filter.Value())
+    }
+    return t, cmd
+}
+
+// filterView shows the open input or the applied query with the match count
+func (t DataTable) filterView() string {
+    count := theme.Caption.Render(t.rowCount())
+    if t.filtering {
+        return t.filter.View() + "  " + count
+    }
+    if len(t.terms) > 0 {
+        return theme.Caption.Render("/"+t.filter.Value()) + "  " + count
+    }
+    return ""
+}
@@ -0,0 +1,147 @@
+package organisms
+
+import (
+    "sort"
+    "strconv"
+
+    "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
+)
+
+// groupSpan is a run of display positions sharing a group value
+type groupSpan struct {
+    key        string
+    start, end int // Positions in view, end exclusive
+}
+
+// GroupBy gathers the rows under a header per value of col, each folded
+// and unfolded with space or a click. A negative col stops grouping.
+func (t *DataTable) GroupBy(col int) {
+    t.grouped = col >= 0 && col < len(t.columns)
+    t.groupCol = col
+    t.collapsed = nil
+    t.rebuild()
+}
+
+// GroupColumn returns the column rows are grouped by, or -1
+func (t DataTable) GroupColumn() int {
+    if !t.grouped {
+        return -1
+    }
+    return t.groupCol
+}
+
+// GroupCollapsed reports whether the group of rows holding value is folded
+func (t DataTable) GroupCollapsed(value string) bool {
+    return t.collapsed[value]
+}
+
+// ToggleGroup folds or unfolds the group of rows holding value. A cursor in
+// a group being folded moves onto its header.
+func (t *DataTable) ToggleGroup(value string) {
+    t.collapsed = cloneMap(t.collapsed)
+    t.collapsed[value] = !t.collapsed[value]
+    t.SetCursor(t.cursor)
+}
+
+// group orders view, which the caller owns, by group value, keeping the sort
+// within each group, and records where each group runs
+func (t *DataTable) group(view []int) {
+    t.spans, t.spanAt = nil, nil
+    if !t.grouped {
+        return
+    }
+    cmp := t.columns[t.groupCol].Compare
+    if cmp == nil {
+        cmp = CompareText
+    }
+    sort.SliceStable(view, func(i, j int) bool {
+        return cmp(t.cell(view[i], t.groupCol), t.cell(view[j], t.groupCol)) < 0
+    })
+
+    t.spanAt = make([]int, len(view))
+    for p, r := range view {
+        key := t.cell(r, t.groupCol)
+        if n := len(t.spans); n == 0 || t.spans[n-1].key != key {
+            t.spans = append(t.spans, groupSpan{key: key, start: p})
+        }
+        t.spans[len(t.spans)-1].end = p + 1
+        t.spanAt[p] = len(t.spans) - 1
+    }
+}
+
+// groupOf returns the group holding display position pos, nil when the rows
+// are not grouped
+func (t DataTable) groupOf(pos int) *groupSpan {
+    if pos < 0 || pos >= len(t.spanAt) {
+        return nil
+    }
+    return &t.spans[t.spanAt[pos]]
+}
+
+// folded reports whether pos sits in a folded group. The cursor rests on the
+// first position of such a group, which stands for its header.
+func (t DataTable) folded(pos int) bool {
+    s := t.groupOf(pos)
+    return s != nil && t.collapsed[s.key]
+}
+
+// moveCursor steps the cursor delta rows, a folded group counting as one
+func (t *DataTable) moveCursor(delta int) {
+    pos := t.cursor
+    for ; delta > 0 && pos < len(t.view)-1; delta-- {
+        if s := t.groupOf(pos); s != nil && t.collapsed[s.key] {
+            pos = min(s.end, len(t.view)-1)
+        } else {
+            pos++
+        }
+    }
+    for ; delta < 0 && pos > 0; delta++ {
+        pos--
+        if s := t.groupOf(pos); s != nil && t.collapsed[s.key] {
+            pos = s.start
+        }
+    }
+    t.SetCursor(pos)
+}
+
+func (t DataTable) groupZone(i int) string {
+    return t.id + ":g" + strconv.Itoa(i)
+}
+
+// groupView draws the header of group i: fold glyph, value and row count.
+// A folded group's header carries the cursor for it.
+func (t DataTable) groupView(i, width int) string {
+    s := t.spans[i]
+    glyph := "▾"
+    if t.collapsed[s.key] {
+        glyph = "▸"
+    }
+    key := s.key
+    if key == "" {
+        key = "—"
+    }
+
+    style := t.styles.Cell.Copy().Bold(true)
+    if t.collapsed[s.key] && t.spanAt[t.cursor] == i {
+        style = selectedStyle(t.styles).Bold(true)
+    }
+    content := glyph + " " + key + " " + theme.Caption.Render(strconv.Itoa(s.end-s.start))
+    return zone.Mark(t.groupZone(i), paintRendered(content, style, width-cellPadding))
+}
+
+// posLines renders what display position pos adds to the body: the header
+// of the group it opens, then the row and its detail pane unless the group
+// is folded
+func (t DataTable) posLines(pos, width int) []string {
+    var lines []string
+    s := t.groupOf(pos)
+    if s != nil && s.start == pos {
+        lines = append(lines, t.groupView(t.spanAt[pos], width))
+    }
+    if s != nil && t.collapsed[s.key] {
+        return lines
+    }
+    lines = append(lines, t.rowView(pos))
+    return append(lines, t.detailLines(t.view[pos], width)...)
+}
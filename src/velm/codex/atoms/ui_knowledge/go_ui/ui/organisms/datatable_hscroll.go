@@ -0,0 +1,123 @@
+package organisms
+
+import (
+    "strconv"
+
+    "gnostic-tui/ui/theme"
+)
+
+// SetHorizontalScroll lets columns run past the table width instead of being
+// shrunk or hidden to fit. The first frozen columns in display order stay put
+// while the rest scroll to follow the column picked with < and >.
+func (t *DataTable) SetHorizontalScroll(on bool, frozen int) {
+    t.hscroll, t.frozen, t.hoff = on, max(frozen, 0), 0
+    t.layout()
+    t.scrollColumns()
+}
+
+// scrolling reports whether the columns currently overflow the width
+func (t DataTable) scrolling() bool {
+    if !t.hscroll || t.width <= 0 {
+        return false
+    }
+    used := 0
+    for _, w := range t.widths {
+        if w > 0 {
+            used += w + cellPadding
+        }
+    }
+    return used > t.width
+}
+
+// splitColumns divides the visible columns in display order into the frozen
+// ones and those that scroll
+func (t DataTable) splitColumns() (frozen, rest []int) {
+    for _, i := range t.order {
+        if t.widths[i] == 0 {
+            continue
+        }
+        if len(frozen) < t.frozen {
+            frozen = append(frozen, i)
+        } else {
+            rest = append(rest, i)
+        }
+    }
+    return frozen, rest
+}
+
+// drawnColumns returns the columns to draw this frame, left to right
+func (t DataTable) drawnColumns() []int {
+    if !t.scrolling() {
+        var all []int
+        for _, i := range t.order {
+            if t.widths[i] > 0 {
+                all = append(all, i)
+            }
+        }
+        return all
+    }
+
+    frozen, rest := t.splitColumns()
+    drawn := append([]int(nil), frozen...)
+    used := 0
+    for _, i := range frozen {
+        used += t.widths[i] + cellPadding
+    }
+    for p := t.hoff; p < len(rest); p++ {
+        w := t.widths[rest[p]] + cellPadding
+        if used+w > t.width && p > t.hoff {
+            break
+        }
+        drawn = append(drawn, rest[p])
+        used += w
+    }
+    return drawn
+}
+
+// scrollColumns moves the horizontal offset just far enough to show the
+// picked column
+func (t *DataTable) scrollColumns() {
+    if !t.scrolling() {
+        t.hoff = 0
+        return
+    }
+    _, rest := t.splitColumns()
+    pos := -1
+    for p, i := range rest {
+        if i == t.col {
+            pos = p
+        }
+    }
+    t.hoff = clamp(t.hoff, 0, max(len(rest)-1, 0))
+    if pos < 0 {
+        return // A frozen column is always on screen
+    }
+    if pos < t.hoff {
+        t.hoff = pos
+    }
+    for t.hoff < pos && !t.columnDrawn(t.col) {
+        t.hoff++
+    }
+}
+
+func (t DataTable) columnDrawn(col int) bool {
+    for _, i := range t.drawnColumns() {
+        if i == col {
+            return true
+        }
+    }
+    return false
+}
+
+// hscrollView counts the columns scrolled off either side, e.g. "◂ 2 · 3 ▸"
+func (t DataTable) hscrollView() string {
+    if !t.scrolling() {
+        return ""
+    }
+    frozen, rest := t.splitColumns()
+    right := len(rest) - (len(t.drawnColumns()) - len(frozen)) - t.hoff
+    if t.hoff == 0 && right == 0 {
+        return ""
+    }
+    return theme.Caption.Render("◂ " + strconv.Itoa(t.hoff) + " · " + strconv.Itoa(right) + " ▸")
+}
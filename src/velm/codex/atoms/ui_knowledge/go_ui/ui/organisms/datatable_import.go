@@ -0,0 +1,180 @@
+package organisms
+
+import (
+    "bytes"
+    "encoding/csv"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io"
+    "strconv"
+    "strings"
+    "time"
+
+    "gnostic-tui/ui/atoms"
+)
+
+const (
+    importMaxWidth = 40 // Widest an inferred column starts out
+    importRows     = 20 // Rows shown before the table scrolls
+)
+
+// dateLayouts are tried in order when inferring date columns
+var dateLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02", "02/01/2006"}
+
+// TableFromCSV reads a CSV with a header row into a table, inferring a
+// comparator, alignment and width for every column
+func TableFromCSV(r io.Reader) (DataTable, error) {
+    cr := csv.NewReader(r)
+    cr.FieldsPerRecord = -1 // Short rows are padded rather than rejected
+    records, err := cr.ReadAll()
+    if err != nil {
+        return DataTable{}, fmt.Errorf("read csv: %w", err)
+    }
+    if len(records) == 0 {
+        return DataTable{}, errors.New("read csv: no header row")
+    }
+
+    rows := make([]Row, len(records)-1)
+    for i, rec := range records[1:] {
+        rows[i] = Row(rec)
+    }
+    return inferTable(records[0], rows), nil
+}
+
+// TableFromJSON reads an array of objects into a table. Columns follow the
+// keys in the order they first appear; nested values show as compact JSON.
+func TableFromJSON(r io.Reader) (DataTable, error) {
+    var items []json.RawMessage
+    if err := json.NewDecoder(r).Decode(&items); err != nil {
+        return DataTable{}, fmt.Errorf("read json: %w", err)
+    }
+
+    var titles []string
+    index := map[string]int{}
+    records := make([]map[string]string, len(items))
+    for i, item := range items {
+        keys, values, err := jsonObject(item)
+        if err != nil {
+            return DataTable{}, fmt.Errorf("read json: item %d: %w", i, err)
+        }
+        records[i] = values
+        for _, k := range keys {
+            if _, ok := index[k]; !ok {
+                index[k] = len(titles)
+                titles = append(titles, k)
+            }
+        }
+    }
+
+    rows := make([]Row, len(records))
+    for i, rec := range records {
+        rows[i] = make(Row, len(titles))
+        for j, k := range titles {
+            rows[i][j] = rec[k]
+        }
+    }
+    return inferTable(titles, rows), nil
+}
+
+// jsonObject decodes one object, keeping its keys in document order
+func jsonObject(raw json.RawMessage) ([]string, map[string]string, error) {
+    dec := json.NewDecoder(bytes.NewReader(raw))
+    dec.UseNumber()
+    if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+        return nil, nil, errors.New("not an object")
+    }
+
+    var keys []string
+    values := map[string]string{}
+    for dec.More() {
+        tok, err := dec.Token()
+        if err != nil {
+            return nil, nil, err
+        }
+        key := tok.(string)
+        var v json.RawMessage
+        if err := dec.Decode(&v); err != nil {
+            return nil, nil, err
+        }
+        keys = append(keys, key)
+        values[key] = jsonCell(v)
+    }
+    return keys, values, nil
+}
+
+// jsonCell prints a JSON value as a cell: strings unquoted, null empty
+func jsonCell(v json.RawMessage) string {
+    var s string
+    if json.Unmarshal(v, &s) == nil {
+        return s
+    }
+    if string(v) == "null" {
+        return ""
+    }
+    var compact bytes.Buffer
+    if json.Compact(&compact, v) == nil {
+        return compact.String()
+    }
+    return string(v)
+}
+
+// inferTable types each column by its non-empty cells and sizes it to its content
+func inferTable(titles []string, rows []Row) DataTable {
+    columns := make([]Column, len(titles))
+    for i, title := range titles {
+        columns[i] = inferColumn(title, rows, i)
+    }
+
+    t := NewTable(columns, rows)
+    t.SetHeight(min(len(rows), importRows) + 2)
+    t.Focus()
+    return t
+}
+
+// inferColumn picks a comparator that every non-empty cell parses with:
+// numbers and sizes sort by value and align right, dates sort by time, and
+// anything else is flexible-width text
+func inferColumn(title string, rows []Row, col int) Column {
+    c := Column{Title: title, Auto: true, MaxWidth: importMaxWidth, MinWidth: min(len(title), 8)}
+
+    var cells []string
+    for _, r := range rows {
+        if col < len(r) && strings.TrimSpace(r[col]) != "" {
+            cells = append(cells, strings.TrimSpace(r[col]))
+        }
+    }
+    every := func(ok func(string) bool) bool {
+        for _, cell := range cells {
+            if !ok(cell) {
+                return false
+            }
+        }
+        return len(cells) > 0
+    }
+
+    isNumber := func(s string) bool {
+        _, err := strconv.ParseFloat(s, 64)
+        return err == nil
+    }
+    isSize := func(s string) bool {
+        _, err := atoms.ParseBytes(s)
+        return err == nil && !isNumber(s)
+    }
+    switch {
+    case every(isNumber):
+        c.Compare, c.Render = CompareNumeric, RightAlignCell
+        return c
+    case every(isSize):
+        c.Compare, c.Render = CompareSize, RightAlignCell
+        return c
+    }
+    for _, layout := range dateLayouts {
+        if every(func(s string) bool { _, err := time.Parse(layout, s); return err == nil }) {
+            c.Compare = CompareDate(layout)
+            return c
+        }
+    }
+    c.Flex = 1
+    return c
+}
@@ -0,0 +1,170 @@
+package organisms
+
+import (
+    "sort"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+)
+
+// cellPadding is the space the cell style adds around every column
+const cellPadding = 2
+
+// SetWidth lays the columns out across w cells: flexible columns share the
+// space left over, shrinkable ones give way down to their MinWidth, and when
+// even that does not fit the lowest-priority columns are hidden, unless
+// horizontal scrolling is on. Zero keeps every column at its own width.
+func (t *DataTable) SetWidth(w int) {
+    t.width = max(w, 0)
+    t.layout()
+    t.scrollColumns()
+}
+
+// FitWindow keeps the table as wide as the terminal less inset, the space
+// taken by surrounding padding and borders, re-laying it out on every
+// tea.WindowSizeMsg
+func (t *DataTable) FitWindow(inset int) {
+    t.inset = inset
+    t.fit = true
+}
+
+func (t *DataTable) resize(msg tea.WindowSizeMsg) {
+    if t.fit {
+        t.SetWidth(msg.Width - t.inset)
+    }
+}
+
+// ColumnHidden reports whether the layout had to drop column i for lack of space
+func (t DataTable) ColumnHidden(i int) bool {
+    return i < len(t.widths) && t.widths[i] == 0
+}
+
+// layout recomputes the drawn width of every column; hidden columns get 0
+func (t *DataTable) layout() {
+    base := make([]int, len(t.columns))
+    for i, c := range t.columns {
+        base[i] = c.Width
+        if c.Auto {
+            base[i] = t.contentWidth(i)
+        }
+        base[i] = boundWidth(c, base[i])
+        if t.userHidden[i] {
+            base[i] = 0
+        }
+    }
+    if t.width <= 0 {
+        t.widths = base
+        return
+    }
+
+    floor := func(i int) int {
+        if c := t.columns[i]; c.MinWidth > 0 {
+            return min(c.MinWidth, base[i])
+        }
+        return base[i]
+    }
+
+    // Drop columns, least important first, until the minimum widths fit
+    shown := make([]bool, len(t.columns))
+    need := 0
+    for i := range shown {
+        if shown[i] = !t.userHidden[i]; shown[i] {
+            need += floor(i) + cellPadding
+        }
+    }
+    order := make([]int, len(t.columns))
+    for i := range order {
+        order[i] = i
+    }
+    sort.SliceStable(order, func(a, b int) bool {
+        pa, pb := t.columns[order[a]].Priority, t.columns[order[b]].Priority
+        if pa != pb {
+            return pa < pb
+        }
+        return order[a] > order[b] // Among equals the rightmost goes first
+    })
+    for _, i := range order {
+        if t.hscroll || need <= t.width || countShown(shown) == 1 {
+            break
+        }
+        if !shown[i] {
+            continue
+        }
+        shown[i] = false
+        need -= floor(i) + cellPadding
+    }
+
+    widths := make([]int, len(t.columns))
+    used := 0
+    for i := range widths {
+        if shown[i] {
+            widths[i] = base[i]
+            used += base[i] + cellPadding
+        }
+    }
+
+    // Too wide: take a cell at a time from the widest column that can shrink
+    for used > t.width && !t.hscroll {
+        widest := -1
+        for i, w := range widths {
+            if shown[i] && w > floor(i) && (widest < 0 || w > widths[widest]) {
+                widest = i
+            }
+        }
+        if widest < 0 {
+            break
+        }
+        widths[widest]--
+        used--
+    }
+
+    // Room to spare: hand it to the flexible columns by weight
+    for used < t.width {
+        grew := false
+        for i, c := range t.columns {
+            if used == t.width {
+                break
+            }
+            if !shown[i] || c.Flex <= 0 || (c.MaxWidth > 0 && widths[i] >= c.MaxWidth) {
+                continue
+            }
+            n := min(c.Flex, t.width-used)
+            if c.MaxWidth > 0 {
+                n = min(n, c.MaxWidth-widths[i])
+            }
+            widths[i] += n
+            used += n
+            grew = true
+        }
+        if !grew {
+            break
+        }
+    }
+    t.widths = widths
+}
+
+// contentWidth measures the widest cell of column i, title included
+func (t DataTable) contentWidth(i int) int {
+    w := lipgloss.Width(t.columns[i].Title) + 2 // Room for the sort glyph
+    for r := range t.rows {
+        w = max(w, lipgloss.Width(t.cell(r, i)))
+    }
+    return w
+}
+
+func boundWidth(c Column, w int) int {
+    if c.MaxWidth > 0 {
+        w = min(w, c.MaxWidth)
+    }
+    return max(w, c.MinWidth, 1)
+}
+
+func countShown(shown []bool) int {
+    n := 0
+    for _, s := range shown {
+        if s {
+            n++
+        }
+    }
+    return n
+}
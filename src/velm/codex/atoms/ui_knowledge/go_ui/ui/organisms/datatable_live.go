@@ -0,0 +1,100 @@
+package organisms
+
+// RowUpsertMsg replaces the row whose key column matches Row's, or adds Row
+// when there is none. The cursor stays on the row it was on and the current
+// sort and filter place the new values.
+type RowUpsertMsg struct {
+    Row Row
+}
+
+// RowDeleteMsg removes the row whose key column holds ID
+type RowDeleteMsg struct {
+    ID string
+}
+
+// SetKeyColumn picks the column whose values identify rows for RowUpsertMsg
+// and RowDeleteMsg; it is the first column by default
+func (t *DataTable) SetKeyColumn(col int) {
+    t.keyCol = clamp(col, 0, len(t.columns)-1)
+}
+
+// rowByID finds the row whose key column holds id, or -1
+func (t DataTable) rowByID(id string) int {
+    for i := range t.rows {
+        if t.cell(i, t.keyCol) == id {
+            return i
+        }
+    }
+    return -1
+}
+
+// UpsertRow replaces the row with the same key as row, or appends it
+func (t *DataTable) UpsertRow(row Row) {
+    rows := append([]Row(nil), t.rows...)
+    if i := t.rowByID(rowKey(row, t.keyCol)); i >= 0 {
+        rows[i] = row
+    } else {
+        rows = append(rows, row)
+    }
+    t.rows = rows
+    t.layout()
+    t.rebuild()
+}
+
+// DeleteRow removes the row whose key column holds id. A cursor on it moves
+// to the row that takes its place.
+func (t *DataTable) DeleteRow(id string) {
+    i := t.rowByID(id)
+    if i < 0 {
+        return
+    }
+    t.rows = append(append([]Row(nil), t.rows[:i]...), t.rows[i+1:]...)
+
+    // Rows after i move up one; shift everything that points at them
+    view := make([]int, len(t.view))
+    for p, r := range t.view {
+        view[p] = shiftIndex(r, i)
+    }
+    t.view = view
+
+    if len(t.expanded) > 0 {
+        expanded := make(map[int]bool, len(t.expanded))
+        for r, on := range t.expanded {
+            if r := shiftIndex(r, i); r >= 0 && on {
+                expanded[r] = true
+            }
+        }
+        t.expanded = expanded
+    }
+
+    if t.edit != nil {
+        ed := *t.edit
+        ed.row = shiftIndex(ed.row, i)
+        t.edit = &ed
+        if ed.row < 0 {
+            t.edit = nil
+        }
+    }
+
+    t.layout()
+    t.rebuild()
+}
+
+// shiftIndex maps a row index across the removal of row removed, -1 for the
+// removed row itself
+func shiftIndex(r, removed int) int {
+    switch {
+    case r == removed:
+        return -1
+    case r > removed:
+        return r - 1
+    }
+    return r
+}
+
+func rowKey(row Row, col int) string {
+    if col < len(row) {
+        return row[col]
+    }
+    return ""
+}
@@ -0,0 +1,105 @@
+package organisms
+
+import (
+    "strings"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+// TableState is what the body of a table is showing
+type TableState int
+
+const (
+    StateRows    TableState = iota
+    StateEmpty              // No rows, or none the filter lets through
+    StateLoading            // A DataSource fetch is in flight
+    StateError              // The last fetch failed
+)
+
+// PlaceholderFunc draws the body of a table in width by height cells in
+// place of its rows. The table is passed for its Err and FilterValue.
+type PlaceholderFunc func(t DataTable, width, height int) string
+
+// State reports what the body is showing
+func (t DataTable) State() TableState {
+    switch {
+    case t.loading:
+        return StateLoading
+    case t.loadErr != nil:
+        return StateError
+    case len(t.view) == 0:
+        return StateEmpty
+    }
+    return StateRows
+}
+
+// SetPlaceholder replaces the view drawn for state; nil restores the default
+func (t *DataTable) SetPlaceholder(state TableState, fn PlaceholderFunc) {
+    t.placeholders = cloneMap(t.placeholders)
+    t.placeholders[state] = fn
+}
+
+// EmptyPlaceholder shows text centered in the body, or a note that the
+// filter hides every row when one is set
+func EmptyPlaceholder(text string) PlaceholderFunc {
+    return func(t DataTable, width, height int) string {
+        msg := text
+        if t.FilterValue() != "" {
+            msg = "No rows match the filter"
+        }
+        return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center,
+            theme.Caption.Render(atoms.Truncate(msg, width, "…")))
+    }
+}
+
+// SkeletonPlaceholder fills the body with shimmering skeleton rows
+func SkeletonPlaceholder(t DataTable, width, height int) string {
+    lines := make([]string, height)
+    for i := range lines {
+        lines[i] = " " + atoms.Skeleton(width-2, 1) + " "
+    }
+    return strings.Join(lines, "\n")
+}
+
+// ErrorPlaceholder shows the fetch error and the key that retries it
+func ErrorPlaceholder(t DataTable, width, height int) string {
+    msg := lipgloss.NewStyle().Foreground(theme.Danger).Render(
+        atoms.Truncate(" "+atoms.Icon("cross")+" "+t.Err().Error(), width, "…"))
+    return msg + "\n " + atoms.Kbd("r") + theme.Caption.Render(" retry")
+}
+
+// placeholderView stands in for the rows while there are none to show; it
+// returns false when the rows should show
+func (t DataTable) placeholderView(width int) ([]string, bool) {
+    state := t.State()
+    if state == StateRows {
+        return nil, false
+    }
+    fn := t.placeholders[state]
+    if fn == nil {
+        switch state {
+        case StateEmpty:
+            fn = EmptyPlaceholder("No rows")
+        case StateLoading:
+            fn = SkeletonPlaceholder
+        case StateError:
+            fn = ErrorPlaceholder
+        }
+    }
+
+    h := t.bodyHeight()
+    lines := strings.Split(fn(t, width, h), "\n")
+    if len(lines) > h {
+        lines = lines[:h]
+    }
+    for len(lines) < h {
+        lines = append(lines, "")
+    }
+    for i, l := range lines {
+        l = atoms.Truncate(l, width, "…")
+        lines[i] = l + strings.Repeat(" ", max(width-lipgloss.Width(l), 0))
+    }
+    return lines, true
+}
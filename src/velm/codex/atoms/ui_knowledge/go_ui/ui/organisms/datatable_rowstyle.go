@@ -0,0 +1,39 @@
+package organisms
+
+import (
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// RowStyleFunc tints a row by its content, e.g. dimming retired records or
+// putting failures on a Danger background. Properties it leaves unset fall
+// back to the cell style; the selection highlight always wins.
+type RowStyleFunc func(row Row) lipgloss.Style
+
+// SetRowStyle sets the hook that styles each row; nil styles none
+func (t *DataTable) SetRowStyle(fn RowStyleFunc) {
+    t.rowStyle = fn
+}
+
+// SetZebra shades every other row with the Surface color
+func (t *DataTable) SetZebra(on bool) {
+    t.zebra = on
+}
+
+// rowStyleAt is the style of the row at display position pos
+func (t DataTable) rowStyleAt(pos int) lipgloss.Style {
+    if pos == t.cursor {
+        return selectedStyle(t.styles)
+    }
+    style := t.styles.Cell
+    if t.zebra && pos%2 == 1 {
+        style = style.Copy().Background(theme.Surface)
+    }
+    if t.rowStyle == nil {
+        return style
+    }
+    // Inherit skips padding, so the cell padding is carried over by hand
+    return t.rowStyle(t.rows[t.view[pos]]).Copy().Inherit(style).
+        PaddingLeft(style.GetPaddingLeft()).
+        PaddingRight(style.GetPaddingRight())
+}
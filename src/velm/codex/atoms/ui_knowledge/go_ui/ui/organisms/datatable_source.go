@@ -0,0 +1,121 @@
+package organisms
+
+import (
+    "context"
+    "fmt"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "gnostic-tui/ui/atoms"
+)
+
+// PageRequest selects the rows to fetch; Size 0 asks for all of them
+type PageRequest struct {
+    Index, Size int
+}
+
+// SortSpec is the sort the user picked; Dir is SortNone when unsorted
+type SortSpec struct {
+    Col int
+    Dir SortDir
+}
+
+// FetchResult is one page of rows and the number of rows matching the filter
+// across all pages
+type FetchResult struct {
+    Rows  []Row
+    Total int
+}
+
+// DataSource loads table rows from somewhere slow, such as an API or a
+// database. Fetch runs off the update loop and should give up once ctx is
+// cancelled, which happens as soon as a newer fetch replaces it. Sorting,
+// filtering and paging are left to the source.
+type DataSource interface {
+    Fetch(ctx context.Context, page PageRequest, sort SortSpec, filter string) (FetchResult, error)
+}
+
+// fetchQuery is everything a fetch depends on, so a change can trigger a new one
+type fetchQuery struct {
+    page   PageRequest
+    sort   SortSpec
+    filter string
+}
+
+// tableFetchedMsg carries a fetch result back to the table that asked for it
+type tableFetchedMsg struct {
+    id     string
+    seq    int
+    result FetchResult
+    err    error
+}
+
+// SetSource backs the table with a DataSource instead of fixed rows. The
+// returned command runs the first fetch; later ones follow sort, filter and
+// page changes by themselves, and r fetches again.
+func (t *DataTable) SetSource(src DataSource) tea.Cmd {
+    t.source = src
+    return t.Refresh()
+}
+
+// Loading reports whether a fetch is in flight
+func (t DataTable) Loading() bool {
+    return t.loading
+}
+
+// Err returns the error of the last fetch, or nil
+func (t DataTable) Err() error {
+    return t.loadErr
+}
+
+// Refresh fetches the current page again, cancelling any fetch in flight
+func (t *DataTable) Refresh() tea.Cmd {
+    if t.source == nil {
+        return nil
+    }
+    if t.cancel != nil {
+        t.cancel()
+    }
+    ctx, cancel := context.WithCancel(context.Background())
+    t.cancel = cancel
+    t.seq++
+    t.loading = true
+    t.loadErr = nil
+
+    src, q, id, seq := t.source, t.query(), t.id, t.seq
+    fetch := func() tea.Msg {
+        res, err := src.Fetch(ctx, q.page, q.sort, q.filter)
+        return tableFetchedMsg{id: id, seq: seq, result: res, err: err}
+    }
+    return tea.Batch(fetch, atoms.SkeletonTick())
+}
+
+func (t DataTable) query() fetchQuery {
+    q := fetchQuery{sort: SortSpec{t.sortCol, t.sortDir}, filter: t.filter.Value()}
+    if t.paged {
+        q.page = PageRequest{Index: t.pager.Page(), Size: t.pager.PerPage}
+    }
+    return q
+}
+
+// fetched applies a fetch result, ignoring any that a newer fetch superseded
+func (t *DataTable) fetched(msg tableFetchedMsg) {
+    if msg.id != t.id || msg.seq != t.seq {
+        return
+    }
+    t.loading = false
+    t.cancel = nil
+    if msg.err != nil {
+        t.loadErr = msg.err
+        return
+    }
+    t.total = msg.result.Total
+    t.SetRows(msg.result.Rows)
+}
+
+// rowCount describes how many rows the filter left, for the footer
+func (t DataTable) rowCount() string {
+    if t.source != nil {
+        return fmt.Sprintf("%d rows", t.total)
+    }
+    return fmt.Sprintf("%d of %d rows", len(t.view), len(t.rows))
+}
@@ -3,6 +3,8 @@ package organisms
 import (
     "github.com/charmbracelet/bubbles/help"
     "github.com/charmbracelet/bubbles/key"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
 )
 
 // KeyMap defines the available keybindings
@@ -12,6 +14,12 @@ type KeyMap struct {
     Enter key.Binding
     Quit  key.Binding
     Help  key.Binding
+
+    // Vim-style motions of the table and log viewport, see VimNav
+    Top          key.Binding // Pressed twice, as in gg
+    Bottom       key.Binding // After a count, jumps to that line instead
+    HalfPageDown key.Binding
+    HalfPageUp   key.Binding
 }
 
 func (k KeyMap) ShortHelp() []key.Binding {
@@ -21,6 +29,7 @@ func (k KeyMap) ShortHelp() []key.Binding {
 func (k KeyMap) FullHelp() [][]key.Binding {
     return [][]key.Binding{
         {k.Up, k.Down, k.Enter},
+        {k.Top, k.Bottom, k.HalfPageDown, k.HalfPageUp},
         {k.Quit, k.Help},
     }
 }
@@ -31,8 +40,19 @@ var Keys = KeyMap{
     Enter: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
     Quit:  key.NewBinding(key.WithKeys("q", "esc"), key.WithHelp("q", "quit")),
     Help:  key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "toggle help")),
+
+    Top:          key.NewBinding(key.WithKeys("g"), key.WithHelp("gg", "top")),
+    Bottom:       key.NewBinding(key.WithKeys("G"), key.WithHelp("G/42G", "bottom/line")),
+    HalfPageDown: key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("ctrl+d", "half page down")),
+    HalfPageUp:   key.NewBinding(key.WithKeys("ctrl+u"), key.WithHelp("ctrl+u", "half page up")),
 }
 
+// NewHelp creates a help footer that renders its keys as key caps
 func NewHelp() help.Model {
-    return help.New()
+    h := help.New()
+    h.Styles.ShortKey = atoms.KbdStyle()
+    h.Styles.FullKey = atoms.KbdStyle()
+    h.Styles.ShortDesc = theme.Caption
+    h.Styles.FullDesc = theme.Caption
+    return h
 }
\ No newline at end of file
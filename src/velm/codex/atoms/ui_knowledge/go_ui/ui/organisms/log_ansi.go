@@ -0,0 +1,154 @@
+package organisms
+
+import (
+    "fmt"
+    "strings"
+    "unicode/utf8"
+)
+
+// AnsiMode decides what becomes of escape sequences and control characters
+// in text pushed to a LogBuffer, so piped program output cannot move the
+// cursor, clear the screen or otherwise break the layout
+type AnsiMode int
+
+const (
+    AnsiStrip    AnsiMode = iota // Drop every escape sequence and control character
+    AnsiPreserve                 // Keep well-formed SGR colors and styles, drop the rest
+    AnsiEscape                   // Show escapes and controls as visible text, e.g. ^[[2J
+)
+
+// SetAnsiMode sets how later lines are cleaned up; lines already held keep
+// the form they were pushed in
+func (b *LogBuffer) SetAnsiMode(mode AnsiMode) {
+    b.ansi = mode
+}
+
+// sanitize makes one line of program output safe to draw. Tabs expand to
+// stops of 8 and a carriage return followed by more text starts the line
+// over, as a terminal would show it, except in AnsiEscape where both are
+// shown.
+func sanitize(s string, mode AnsiMode) string {
+    var b strings.Builder
+    col, styled := 0, false
+    for i := 0; i < len(s); {
+        c := s[i]
+        switch {
+        case c == 0x1b:
+            n, sgr := escapeSeq(s[i:])
+            switch {
+            case mode == AnsiEscape:
+                b.WriteString("^[" + visible(s[i+1:i+n]))
+                col += n + 1
+            case mode == AnsiPreserve && sgr:
+                b.WriteString(s[i : i+n])
+                styled = true
+            }
+            i += n
+            continue
+        case c == '\t' && mode != AnsiEscape:
+            pad := 8 - col%8
+            b.WriteString(strings.Repeat(" ", pad))
+            col += pad
+        case c == '\r' && mode != AnsiEscape:
+            if strings.TrimRight(s[i:], "\r") != "" {
+                // Overwritten by what follows; a trailing one, as in CRLF
+                // text, is just dropped
+                b.Reset()
+                col, styled = 0, false
+            }
+        case c < 0x20 || c == 0x7f:
+            if mode == AnsiEscape {
+                b.WriteString(caret(c))
+                col += 2
+            }
+        default:
+            r, size := utf8.DecodeRuneInString(s[i:])
+            switch {
+            case r == utf8.RuneError && size == 1:
+                b.WriteRune(utf8.RuneError)
+                col++
+            case r >= 0x80 && r <= 0x9f:
+                // C1 controls, including the one-byte CSI some programs emit
+                if mode == AnsiEscape {
+                    fmt.Fprintf(&b, "<U+%04X>", r)
+                    col += 8
+                }
+            default:
+                b.WriteString(s[i : i+size])
+                col++
+            }
+            i += size
+            continue
+        }
+        i++
+    }
+    if styled {
+        b.WriteString("\x1b[0m") // Colors never bleed into the next line
+    }
+    return b.String()
+}
+
+// escapeSeq measures the escape sequence at the start of s and reports
+// whether it is a complete SGR (color and style) sequence
+func escapeSeq(s string) (n int, sgr bool) {
+    if len(s) < 2 {
+        return len(s), false
+    }
+    switch s[1] {
+    case '[':
+        // CSI: parameter bytes, intermediate bytes, then one final byte
+        i := 2
+        for i < len(s) && s[i] >= 0x30 && s[i] <= 0x3f {
+            i++
+        }
+        params := s[2:i]
+        for i < len(s) && s[i] >= 0x20 && s[i] <= 0x2f {
+            i++
+        }
+        if i >= len(s) || s[i] < 0x40 || s[i] > 0x7e {
+            return i, false // Malformed; drop what was read
+        }
+        return i + 1, s[i] == 'm' && i == 2+len(params) && strings.Trim(params, "0123456789;:") == ""
+    case ']', 'P', 'X', '^', '_':
+        // String sequences run to BEL or ST
+        for i := 2; i < len(s); i++ {
+            if s[i] == '\a' {
+                return i + 1, false
+            }
+            if s[i] == 0x1b && i+1 < len(s) && s[i+1] == '\\' {
+                return i + 2, false
+            }
+        }
+        return len(s), false
+    }
+    return 2, false
+}
+
+// visible spells out the body of an escape sequence, controls in caret form
+func visible(s string) string {
+    var b strings.Builder
+    for i := 0; i < len(s); i++ {
+        if c := s[i]; c < 0x20 || c == 0x7f {
+            b.WriteString(caret(c))
+        } else {
+            b.WriteByte(c)
+        }
+    }
+    return b.String()
+}
+
+// caret writes a C0 control or DEL as ^X
+func caret(c byte) string {
+    if c == 0x7f {
+        return "^?"
+    }
+    return "^" + string(rune(c+0x40))
+}
+
+// plainText drops the SGR sequences AnsiPreserve keeps, for searching
+func plainText(s string) string {
+    if !strings.Contains(s, "\x1b") {
+        return s
+    }
+    return sanitize(s, AnsiStrip)
+}
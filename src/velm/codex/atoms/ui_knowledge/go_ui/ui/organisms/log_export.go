@@ -0,0 +1,93 @@
+package organisms
+
+import (
+    "bufio"
+    "bytes"
+    "io"
+    "os"
+    "strings"
+    "time"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+)
+
+// LogScope picks which lines a log export writes
+type LogScope int
+
+const (
+    LogAll     LogScope = iota // Every line held, whatever the filters
+    LogShown                   // The lines the level filter lets through
+    LogMatches                 // The shown lines matching the search
+)
+
+// LogExportedMsg reports a finished log export
+type LogExportedMsg struct {
+    Path  string
+    Lines int
+    Err   error
+}
+
+// SetExportName sets the start of the file names exports write to, which
+// end in a timestamp and .log
+func (b *LogBuffer) SetExportName(name string) {
+    b.exportName = name
+}
+
+// ExportLines returns the lines in scope, oldest first
+func (b LogBuffer) ExportLines(scope LogScope) []LogLine {
+    seqs := b.shown
+    switch scope {
+    case LogAll:
+        return b.Lines()
+    case LogMatches:
+        seqs = b.matches
+    }
+    out := make([]LogLine, len(seqs))
+    for i, seq := range seqs {
+        out[i] = b.line(seq)
+    }
+    return out
+}
+
+// WriteText writes the lines in scope as plain text, one per line with the
+// full time, source and level whatever the columns shown, and nested
+// objects written out in full
+func (b LogBuffer) WriteText(w io.Writer, scope LogScope) error {
+    b.expand = true
+    bw := bufio.NewWriter(w)
+    plain := lipgloss.NewStyle()
+    for _, l := range b.ExportLines(scope) {
+        bw.WriteString(l.Time.Format("2006-01-02T15:04:05.000Z07:00") + " ")
+        if l.Source != "" {
+            bw.WriteString(l.Source + " ")
+        }
+        body := plainText(b.bodyView(l, plain, b.bodyWidth(l)))
+        bw.WriteString(l.Level.tag() + " " + strings.TrimRight(body, " ") + "\n")
+    }
+    return bw.Flush()
+}
+
+// Export writes the lines in scope to <name>-<timestamp>.log in the working
+// directory off the update loop and reports back with a LogExportedMsg
+func (b LogBuffer) Export(scope LogScope) tea.Cmd {
+    var buf bytes.Buffer
+    err := b.WriteText(&buf, scope)
+    n := len(b.ExportLines(scope))
+    path := b.exportName + "-" + time.Now().Format("20060102-150405") + ".log"
+    return func() tea.Msg {
+        if err == nil {
+            err = os.WriteFile(path, buf.Bytes(), 0o644)
+        }
+        return LogExportedMsg{Path: path, Lines: n, Err: err}
+    }
+}
+
+// saveScope is what s saves: the search matches while searching, else the
+// lines shown
+func (b LogBuffer) saveScope() LogScope {
+    if b.query != "" {
+        return LogMatches
+    }
+    return LogShown
+}
@@ -0,0 +1,198 @@
+package organisms
+
+import (
+    "bytes"
+    "encoding/json"
+    "slices"
+    "strconv"
+    "strings"
+    "time"
+)
+
+// SetParseJSON turns on or off reading lines that are a JSON object, as
+// zap, zerolog and slog's JSONHandler write them, into a message and attrs.
+// It is on by default and applies to lines pushed afterwards.
+func (b *LogBuffer) SetParseJSON(on bool) {
+    b.parseJSON = on
+}
+
+// SetExpandObjects draws nested objects field by field, e.g. req.id=7,
+// instead of collapsed to req={…2}; o toggles it
+func (b *LogBuffer) SetExpandObjects(on bool) {
+    b.expand = on
+}
+
+// ExpandObjects reports whether nested objects are drawn field by field
+func (b LogBuffer) ExpandObjects() bool {
+    return b.expand
+}
+
+// Keys holding the message, level, time and source of a JSON line
+var (
+    jsonMessageKeys = []string{"msg", "message"}
+    jsonLevelKeys   = []string{"level", "lvl", "severity"}
+    jsonTimeKeys    = []string{"time", "ts", "timestamp"}
+    jsonSourceKeys  = []string{"logger", "component", "caller", "source"}
+)
+
+// parseJSONLine fills in l from its text when it is a JSON object. The well
+// known keys become the message, level, time and source; the rest become
+// attrs, nested objects keeping their fields.
+func parseJSONLine(l LogLine) (LogLine, bool) {
+    text := strings.TrimSpace(l.Text)
+    if !strings.HasPrefix(text, "{") || !json.Valid([]byte(text)) {
+        return l, false
+    }
+    dec := json.NewDecoder(strings.NewReader(text))
+    dec.UseNumber()
+    fields, err := decodeObject(dec)
+    if err != nil {
+        return l, false
+    }
+
+    l.Text = ""
+    attrs := l.Attrs[:len(l.Attrs):len(l.Attrs)]
+    for _, f := range fields {
+        switch {
+        case f.Fields == nil && slices.Contains(jsonMessageKeys, f.Key) && l.Text == "":
+            l.Text = jsonString(f.Value)
+        case f.Fields == nil && slices.Contains(jsonLevelKeys, f.Key):
+            if level, ok := parseLevel(jsonString(f.Value)); ok {
+                l.Level = level
+                continue
+            }
+            attrs = append(attrs, f)
+        case f.Fields == nil && slices.Contains(jsonTimeKeys, f.Key):
+            if t, ok := parseTime(f.Value); ok {
+                l.Time = t
+                continue
+            }
+            attrs = append(attrs, f)
+        case f.Fields == nil && slices.Contains(jsonSourceKeys, f.Key) && l.Source == "":
+            l.Source = jsonString(f.Value)
+        default:
+            attrs = append(attrs, f)
+        }
+    }
+    l.Attrs = attrs
+    return l, true
+}
+
+// decodeObject reads the object after dec's position, keeping key order.
+// Arrays and scalars are kept as compact JSON.
+func decodeObject(dec *json.Decoder) ([]LogAttr, error) {
+    if _, err := dec.Token(); err != nil { // {
+        return nil, err
+    }
+    fields := []LogAttr{}
+    for dec.More() {
+        tok, err := dec.Token()
+        if err != nil {
+            return nil, err
+        }
+        key, _ := tok.(string)
+        var raw json.RawMessage
+        if err := dec.Decode(&raw); err != nil {
+            return nil, err
+        }
+        f := LogAttr{Key: key}
+        if bytes.HasPrefix(raw, []byte("{")) {
+            sub := json.NewDecoder(bytes.NewReader(raw))
+            sub.UseNumber()
+            if f.Fields, err = decodeObject(sub); err != nil {
+                return nil, err
+            }
+        } else {
+            var buf bytes.Buffer
+            if json.Compact(&buf, raw) == nil {
+                raw = buf.Bytes()
+            }
+            f.Value = jsonValue(raw)
+        }
+        fields = append(fields, f)
+    }
+    _, err := dec.Token() // }
+    return fields, err
+}
+
+// jsonValue writes a JSON scalar as a key=value value: strings bare unless
+// they need quoting, everything else as written
+func jsonValue(raw []byte) string {
+    var s string
+    if raw[0] != '"' || json.Unmarshal(raw, &s) != nil {
+        return string(raw)
+    }
+    if s == "" || strings.ContainsAny(s, " =\"\t\n") {
+        return strconv.Quote(s)
+    }
+    return s
+}
+
+// jsonString undoes the quoting of jsonValue
+func jsonString(v string) string {
+    if s, err := strconv.Unquote(v); err == nil {
+        return s
+    }
+    return v
+}
+
+// parseLevel reads a level name, or a number on slog's or pino's scale
+func parseLevel(s string) (LogLevel, bool) {
+    switch strings.ToLower(s) {
+    case "trace", "debug", "dbg":
+        return LevelDebug, true
+    case "info", "inf", "notice":
+        return LevelInfo, true
+    case "warn", "warning", "wrn":
+        return LevelWarn, true
+    case "error", "err", "fatal", "panic", "critical":
+        return LevelError, true
+    }
+    if n, err := strconv.Atoi(s); err == nil {
+        return numericLevel(n)
+    }
+    return 0, false
+}
+
+// numericLevel maps slog's -4/0/4/8 and pino's and bunyan's 10 to 60 onto
+// the four levels; other numbers are not levels
+func numericLevel(n int) (LogLevel, bool) {
+    switch {
+    case n >= 10 && n <= 60 && n%10 == 0:
+        return [...]LogLevel{LevelDebug, LevelDebug, LevelInfo, LevelWarn, LevelError, LevelError}[n/10-1], true
+    case n < -8 || n >= 10:
+        return 0, false
+    case n < int(LevelInfo):
+        return LevelDebug, true
+    case n < int(LevelWarn):
+        return LevelInfo, true
+    case n < int(LevelError):
+        return LevelWarn, true
+    }
+    return LevelError, true
+}
+
+// parseTime reads an RFC 3339 time or Unix seconds, fractions allowed
+func parseTime(v string) (time.Time, bool) {
+    if t, err := time.Parse(time.RFC3339Nano, jsonString(v)); err == nil {
+        return t, true
+    }
+    if f, err := strconv.ParseFloat(v, 64); err == nil {
+        sec := int64(f)
+        return time.Unix(sec, int64((f-float64(sec))*1e9)), true
+    }
+    return time.Time{}, false
+}
+
+// flatAttrs spells out nested objects as dotted keys
+func flatAttrs(attrs []LogAttr, prefix string) []LogAttr {
+    var flat []LogAttr
+    for _, a := range attrs {
+        if a.Fields == nil {
+            flat = append(flat, LogAttr{Key: prefix + a.Key, Value: a.Value})
+            continue
+        }
+        flat = append(flat, flatAttrs(a.Fields, prefix+a.Key+".")...)
+    }
+    return flat
+}
@@ -0,0 +1,126 @@
+package organisms
+
+import (
+    "strings"
+    "time"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// LogLevel is the severity of a log line, numbered as in log/slog
+type LogLevel int
+
+const (
+    LevelDebug LogLevel = -4
+    LevelInfo  LogLevel = 0
+    LevelWarn  LogLevel = 4
+    LevelError LogLevel = 8
+)
+
+// logLevels are the levels toggled by the keys 1 to 4
+var logLevels = [4]LogLevel{LevelDebug, LevelInfo, LevelWarn, LevelError}
+
+// levelKeyDelay is how long a level key waits to see whether it starts a
+// count instead, as in "42G"
+const levelKeyDelay = 300 * time.Millisecond
+
+// logLevelKeyMsg fires once a level key was not followed by a motion
+type logLevelKeyMsg struct {
+    id    string
+    seq   int
+    digit int
+}
+
+func (l LogLevel) String() string {
+    return [...]string{"debug", "info", "warn", "error"}[levelIndex(l)]
+}
+
+// tag is the three letter column drawn before each line
+func (l LogLevel) tag() string {
+    return [...]string{"DBG", "INF", "WRN", "ERR"}[levelIndex(l)]
+}
+
+// levelIndex buckets any level into debug, info, warn or error, so levels in
+// between (slog allows them) still show and filter
+func levelIndex(l LogLevel) int {
+    switch {
+    case l < LevelInfo:
+        return 0
+    case l < LevelWarn:
+        return 1
+    case l < LevelError:
+        return 2
+    }
+    return 3
+}
+
+func levelColor(l LogLevel) lipgloss.TerminalColor {
+    return [...]lipgloss.TerminalColor{theme.Subtext, theme.Primary, theme.Warning, theme.Danger}[levelIndex(l)]
+}
+
+// levelTextColor tints the text of a line: debug dimmed, warnings and
+// errors in their color
+func levelTextColor(l LogLevel) lipgloss.TerminalColor {
+    return [...]lipgloss.TerminalColor{theme.Subtext, theme.Text, theme.Warning, theme.Danger}[levelIndex(l)]
+}
+
+// SetLevelVisible shows or hides the lines of a level
+func (b *LogBuffer) SetLevelVisible(level LogLevel, on bool) {
+    b.hidden[levelIndex(level)] = !on
+    b.refilter()
+}
+
+// LevelVisible reports whether the lines of a level are shown
+func (b LogBuffer) LevelVisible(level LogLevel) bool {
+    return !b.hidden[levelIndex(level)]
+}
+
+// ToggleLevel shows the lines of a level if hidden and hides them otherwise
+func (b *LogBuffer) ToggleLevel(level LogLevel) {
+    b.SetLevelVisible(level, !b.LevelVisible(level))
+}
+
+// levelDigit returns 1 to 4 for the level keys, 0 for any other key
+func levelDigit(msg tea.KeyMsg) int {
+    if msg.Type == tea.KeyRunes && len(msg.Runes) == 1 && msg.Runes[0] >= '1' && msg.Runes[0] <= '4' {
+        return int(msg.Runes[0] - '0')
+    }
+    return 0
+}
+
+// startLevelKey holds a level key back briefly: the digit also starts a
+// count, and the toggle only happens if no more digits or a motion follow
+func (b *LogBuffer) startLevelKey(msg tea.KeyMsg, digit int) tea.Cmd {
+    _, h := b.innerSize()
+    b.nav.Move(msg, b.offset, len(b.shown), h)
+    b.levelSeq++
+    id, seq := b.id, b.levelSeq
+    return tea.Tick(levelKeyDelay, func(time.Time) tea.Msg {
+        return logLevelKeyMsg{id: id, seq: seq, digit: digit}
+    })
+}
+
+// levelKey toggles the level of a key that turned out not to be a count
+func (b *LogBuffer) levelKey(msg logLevelKeyMsg) {
+    if msg.id != b.id || msg.seq != b.levelSeq || b.nav.Pending() != msg.digit {
+        return
+    }
+    b.nav.Reset()
+    b.ToggleLevel(logLevels[msg.digit-1])
+}
+
+// levelTitle names the hidden levels for the border, e.g. "hiding debug"
+func (b LogBuffer) levelTitle() string {
+    var off []string
+    for i, l := range logLevels {
+        if b.hidden[i] {
+            off = append(off, l.String())
+        }
+    }
+    if len(off) == 0 {
+        return ""
+    }
+    return theme.Caption.Render("hiding " + strings.Join(off, ", "))
+}
@@ -0,0 +1,108 @@
+package organisms
+
+import (
+    "sort"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+// SetMinimap shows or hides a one-column map of the whole log down the
+// right edge, marking where warnings and errors are and which part is on
+// screen. Clicking it jumps there; ] and [ step through the problem lines.
+func (b *LogBuffer) SetMinimap(on bool) {
+    b.minimap = on
+}
+
+// Minimap reports whether the minimap is shown
+func (b LogBuffer) Minimap() bool {
+    return b.minimap
+}
+
+// NextProblem scrolls to the next warning or error dir steps away, wrapping
+// around the ends
+func (b *LogBuffer) NextProblem(dir int) {
+    n := len(b.shown)
+    _, h := b.innerSize()
+    from := min(b.offset+h/3, n-1)
+    for i := 1; i <= n; i++ {
+        p := ((from+dir*i)%n + n) % n
+        if b.line(b.shown[p]).Level >= LevelWarn {
+            b.ScrollTo(p - h/3)
+            return
+        }
+    }
+}
+
+// trackProblem notes the shown line seq if it is a warning or error, so the
+// minimap need not scan every line to draw
+func (b *LogBuffer) trackProblem(seq int, level LogLevel) {
+    switch {
+    case level >= LevelError:
+        b.errs = append(b.errs, seq)
+    case level >= LevelWarn:
+        b.warns = append(b.warns, seq)
+    }
+}
+
+// anyBetween reports whether the sorted seqs hold a number in [first, last]
+func anyBetween(seqs []int, first, last int) bool {
+    i := sort.SearchInts(seqs, first)
+    return i < len(seqs) && seqs[i] <= last
+}
+
+// mapSpan is the range of shown positions drawn in minimap row k of h; the
+// rows match the lines on screen one to one while they all fit
+func (b LogBuffer) mapSpan(k, h int) (lo, hi int) {
+    n := len(b.shown)
+    if n <= h {
+        return min(k, n), min(k+1, n)
+    }
+    return k * n / h, (k + 1) * n / h
+}
+
+// minimapView draws the minimap as h cells, top to bottom
+func (b LogBuffer) minimapView(h int) []string {
+    track := lipgloss.NewStyle().Foreground(theme.Border).Render("│")
+    thumb := lipgloss.NewStyle().Foreground(theme.Subtext).Render("┃")
+    warn := lipgloss.NewStyle().Foreground(theme.Warning).Render("█")
+    danger := lipgloss.NewStyle().Foreground(theme.Danger).Render("█")
+
+    cells := make([]string, h)
+    for k := range cells {
+        lo, hi := b.mapSpan(k, h)
+        if lo == hi {
+            cells[k] = " "
+            continue
+        }
+        first, last := b.shown[lo], b.shown[hi-1]
+        switch {
+        case anyBetween(b.errs, first, last):
+            cells[k] = danger
+        case anyBetween(b.warns, first, last):
+            cells[k] = warn
+        case hi > b.offset && lo < b.offset+h:
+            cells[k] = thumb
+        default:
+            cells[k] = track
+        }
+    }
+    return cells
+}
+
+// jumpMap scrolls to the part of the log under minimap row k, landing on
+// its worst line
+func (b *LogBuffer) jumpMap(k int) {
+    _, h := b.innerSize()
+    lo, hi := b.mapSpan(k, h)
+    if lo == hi {
+        return
+    }
+    at := lo
+    for p := lo; p < hi; p++ {
+        if b.line(b.shown[p]).Level > b.line(b.shown[at]).Level {
+            at = p
+        }
+    }
+    b.ScrollTo(at - h/3)
+}
@@ -0,0 +1,57 @@
+package organisms
+
+import (
+    "strconv"
+
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
+)
+
+// Pause freezes the log as it is on screen. Lines pushed meanwhile are held
+// back, up to the line limit, and added when it resumes; p toggles it.
+func (b *LogBuffer) Pause() {
+    b.paused = true
+}
+
+// Resume adds the lines held back while paused and unfreezes the log
+func (b *LogBuffer) Resume() {
+    held := b.held
+    b.paused, b.held = false, nil
+    for _, l := range held {
+        b.Push(l)
+    }
+}
+
+// Paused reports whether the log is frozen
+func (b LogBuffer) Paused() bool {
+    return b.paused
+}
+
+// Buffered is how many lines are held back while paused
+func (b LogBuffer) Buffered() int {
+    return len(b.held)
+}
+
+// hold keeps a line pushed while paused, dropping the oldest past the limit
+// as the log itself would
+func (b *LogBuffer) hold(l LogLine) {
+    if len(b.held) >= b.limit {
+        b.held = b.held[1:]
+    }
+    b.held = append(b.held, l)
+}
+
+// pausePill is the marker shown while paused; clicking it resumes
+func (b LogBuffer) pausePill() string {
+    text := "⏸ paused"
+    if n := len(b.held); n > 0 {
+        text += " • " + strconv.Itoa(n) + " buffered"
+    }
+    pill := lipgloss.NewStyle().
+        Foreground(lipgloss.Color("#000")).
+        Background(theme.Warning).
+        Padding(0, 1).
+        Render(text)
+    return zone.Mark(b.id+":pill", pill)
+}
@@ -0,0 +1,172 @@
+package organisms
+
+import (
+    "sort"
+    "strconv"
+    "strings"
+
+    "github.com/charmbracelet/bubbles/textinput"
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+)
+
+func newSearchInput() textinput.Model {
+    in := textinput.New()
+    in.Prompt = "/"
+    in.Placeholder = "search"
+    in.PromptStyle = lipgloss.NewStyle().Foreground(theme.Primary)
+    in.TextStyle = lipgloss.NewStyle().Foreground(theme.Text)
+    in.PlaceholderStyle = lipgloss.NewStyle().Foreground(theme.Subtext)
+    return in
+}
+
+// Searching reports whether the search input has the keyboard
+func (b LogBuffer) Searching() bool {
+    return b.searching
+}
+
+// Capturing reports whether keys are going to the search input or the
+// selection, so the app should not treat them as shortcuts
+func (b LogBuffer) Capturing() bool {
+    return b.searching || b.visual
+}
+
+// SearchValue returns the text being searched for, "" when not searching
+func (b LogBuffer) SearchValue() string {
+    return b.query
+}
+
+// SetSearch highlights every line containing q, ignoring case, and scrolls
+// to the first match from the top of the screen down. "" ends the search.
+func (b *LogBuffer) SetSearch(q string) {
+    b.search.SetValue(q)
+    b.query = strings.ToLower(q)
+    b.findMatches()
+    if b.match < 0 {
+        return
+    }
+    top := b.dropped
+    if b.offset < len(b.shown) {
+        top = b.shown[b.offset]
+    }
+    b.match = 0
+    for m, seq := range b.matches {
+        if seq >= top {
+            b.match = m
+            break
+        }
+    }
+    b.showMatch()
+}
+
+// findMatches collects the shown lines holding the query
+func (b *LogBuffer) findMatches() {
+    b.matches, b.match = nil, -1
+    if b.query == "" {
+        return
+    }
+    for _, seq := range b.shown {
+        b.trackMatch(seq)
+    }
+}
+
+// NextMatch moves to the match dir steps away, wrapping around the ends
+func (b *LogBuffer) NextMatch(dir int) {
+    if n := len(b.matches); n > 0 {
+        b.match = ((b.match+dir)%n + n) % n
+        b.showMatch()
+    }
+}
+
+// showMatch scrolls the current match into view, a third of the way down
+func (b *LogBuffer) showMatch() {
+    if b.match < 0 {
+        return
+    }
+    _, h := b.innerSize()
+    if p := sort.SearchInts(b.shown, b.matches[b.match]); p < b.offset || p >= b.offset+h {
+        b.ScrollTo(p - h/3)
+    }
+}
+
+// trackMatch adds the shown line seq to the matches if it holds the query
+func (b *LogBuffer) trackMatch(seq int) {
+    if b.query == "" || !strings.Contains(strings.ToLower(searchText(b.line(seq))), b.query) {
+        return
+    }
+    b.matches = append(b.matches, seq)
+    if b.match < 0 {
+        b.match = 0
+    }
+}
+
+// searchText is what a search looks through: the text and its attrs
+func searchText(l LogLine) string {
+    s := plainText(l.Text)
+    for _, a := range flatAttrs(l.Attrs, "") {
+        s += " " + a.Key + "=" + a.Value
+    }
+    return s
+}
+
+// updateSearch drives the search input: matches follow every keystroke,
+// enter keeps them for n/N and esc ends the search
+func (b LogBuffer) updateSearch(msg tea.KeyMsg) (LogBuffer, tea.Cmd) {
+    switch msg.String() {
+    case "enter":
+        b.searching = false
+        b.search.Blur()
+        return b, nil
+    case "esc":
+        b.searching = false
+        b.search.Blur()
+        b.SetSearch("")
+        return b, nil
+    }
+
+    var cmd tea.Cmd
+    before := b.search.Value()
+    b.search, cmd = b.search.Update(msg)
+    if v := b.search.Value(); v != before {
+        b.SetSearch(v)
+    }
+    return b, cmd
+}
+
+// searchMarks flags the runes of text that are part of a match
+func (b LogBuffer) searchMarks(text string) []bool {
+    if b.query == "" {
+        return nil
+    }
+    low := []rune(strings.ToLower(text))
+    if len(low) != len([]rune(text)) {
+        return nil
+    }
+    q := []rune(b.query)
+    var marks []bool
+    for i := 0; i+len(q) <= len(low); i++ {
+        if string(low[i:i+len(q)]) != b.query {
+            continue
+        }
+        if marks == nil {
+            marks = make([]bool, len(low))
+        }
+        for j := i; j < i+len(q); j++ {
+            marks[j] = true
+        }
+    }
+    return marks
+}
+
+// searchTitle is the match counter for the border, e.g. "/err 3 of 17"
+func (b LogBuffer) searchTitle() string {
+    if b.query == "" {
+        return ""
+    }
+    count := "no matches"
+    if len(b.matches) > 0 {
+        count = strconv.Itoa(b.match+1) + " of " + strconv.Itoa(len(b.matches))
+    }
+    return "/" + b.search.Value() + " " + theme.Caption.Render(count)
+}
@@ -0,0 +1,170 @@
+package organisms
+
+import (
+    "sort"
+    "strconv"
+    "strings"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+// LogCopiedMsg reports the selected lines were put on the clipboard, or
+// why they could not be
+type LogCopiedMsg struct {
+    Lines int
+    Err   error
+}
+
+// StartSelection starts selecting lines from the current search match, or
+// else the last line on screen. The log stops following so the selection
+// stays put while lines arrive.
+func (b *LogBuffer) StartSelection() {
+    if len(b.shown) == 0 {
+        return
+    }
+    _, h := b.innerSize()
+    seq := b.shown[min(b.offset+h, len(b.shown))-1]
+    if b.match >= 0 {
+        if p := sort.SearchInts(b.shown, b.matches[b.match]); p >= b.offset && p < b.offset+h {
+            seq = b.shown[p]
+        }
+    }
+    b.visual, b.anchor, b.caret = true, seq, seq
+    b.follow = false
+}
+
+// EndSelection leaves selection mode
+func (b *LogBuffer) EndSelection() {
+    b.visual = false
+}
+
+// Selecting reports whether lines are being selected
+func (b LogBuffer) Selecting() bool {
+    return b.visual
+}
+
+// Selection returns the selected lines that are shown, oldest first
+func (b LogBuffer) Selection() []LogLine {
+    if !b.visual {
+        return nil
+    }
+    lo, hi := b.selectRange()
+    var out []LogLine
+    for _, seq := range b.shown[lo:hi] {
+        out = append(out, b.line(seq))
+    }
+    return out
+}
+
+// CopySelection puts the selected lines on the clipboard as plain text, as
+// drawn but unwrapped, reports back with a LogCopiedMsg and ends the
+// selection
+func (b *LogBuffer) CopySelection() tea.Cmd {
+    lines := b.Selection()
+    b.EndSelection()
+    if len(lines) == 0 {
+        return nil
+    }
+    plain := lipgloss.NewStyle()
+    text := make([]string, len(lines))
+    for i, l := range lines {
+        body := b.bodyView(l, plain, b.bodyWidth(l))
+        text[i] = strings.TrimRight(plainText(b.prefixView(l, plain)+body), " ")
+    }
+    n := len(lines)
+    return atoms.ClipboardExec(strings.Join(text, "\n")+"\n", func(err error) tea.Msg {
+        return LogCopiedMsg{Lines: n, Err: err}
+    })
+}
+
+// selectRange is the span of shown positions selected
+func (b LogBuffer) selectRange() (lo, hi int) {
+    lo, hi = b.anchor, b.caret
+    if lo > hi {
+        lo, hi = hi, lo
+    }
+    return sort.SearchInts(b.shown, lo), sort.SearchInts(b.shown, hi+1)
+}
+
+// selected reports whether the line seq is in the selection
+func (b LogBuffer) selected(seq int) bool {
+    return b.visual && seq >= min(b.anchor, b.caret) && seq <= max(b.anchor, b.caret)
+}
+
+// moveCaret moves the moving end of the selection to shown position pos,
+// scrolling to keep it on screen
+func (b *LogBuffer) moveCaret(pos int) {
+    if len(b.shown) == 0 {
+        return
+    }
+    _, h := b.innerSize()
+    pos = clamp(pos, 0, len(b.shown)-1)
+    b.caret = b.shown[pos]
+    switch {
+    case pos < b.offset:
+        b.ScrollTo(pos)
+    case pos >= b.offset+h:
+        b.ScrollTo(pos - h + 1)
+    }
+    b.follow = false
+}
+
+// updateSelect takes the keys of selection mode: motions move the caret, y
+// copies and esc or v backs out. It reports whether the key was used.
+func (b *LogBuffer) updateSelect(msg tea.KeyMsg) (tea.Cmd, bool) {
+    _, h := b.innerSize()
+    cur := sort.SearchInts(b.shown, b.caret)
+    if to, ok := b.nav.Move(msg, cur, len(b.shown), h); ok {
+        b.moveCaret(to)
+        return nil, true
+    }
+    switch msg.String() {
+    case "y":
+        return b.CopySelection(), true
+    case "esc", "v":
+        b.EndSelection()
+    case "up", "k":
+        b.moveCaret(cur - 1)
+    case "down", "j":
+        b.moveCaret(cur + 1)
+    case "pgup", "b":
+        b.moveCaret(cur - h)
+    case "pgdown", "f":
+        b.moveCaret(cur + h)
+    case "home":
+        b.moveCaret(0)
+    case "end":
+        b.moveCaret(len(b.shown) - 1)
+    default:
+        return nil, false
+    }
+    return nil, true
+}
+
+// forgetSelected keeps the selection's ends on lines still held
+func (b *LogBuffer) forgetSelected() {
+    b.anchor, b.caret = max(b.anchor, b.dropped), max(b.caret, b.dropped)
+}
+
+// selectedView redraws a selected row plain in the selection colors
+func selectedView(row string) string {
+    return lipgloss.NewStyle().
+        Foreground(lipgloss.Color("229")).
+        Background(theme.Primary).
+        Render(plainText(row))
+}
+
+// selectTitle counts the selected lines for the border
+func (b LogBuffer) selectTitle() string {
+    if !b.visual {
+        return ""
+    }
+    lo, hi := b.selectRange()
+    if hi-lo == 1 {
+        return "1 line selected"
+    }
+    return strconv.Itoa(hi-lo) + " lines selected"
+}
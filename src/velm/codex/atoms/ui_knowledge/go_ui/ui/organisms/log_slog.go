@@ -0,0 +1,128 @@
+package organisms
+
+import (
+    "context"
+    "log/slog"
+    "path/filepath"
+    "runtime"
+    "strconv"
+    "strings"
+)
+
+// LogAttr is one key=value pair of a structured log line
+type LogAttr struct {
+    Key    string // Qualified by its groups, e.g. "req.id"
+    Value  string
+    Fields []LogAttr // A nested object's fields, nil for a plain value
+}
+
+// LogHandler is a slog.Handler that sends records to a LogBuffer through a
+// program, so an app keeps its structured logging and gets a live log pane.
+// The level becomes the line's badge, the message its text and the attrs
+// are drawn after it as key=value pairs; with AddSource set, the file and
+// line go in the source column. Records are delivered by a goroutine that
+// runs until Close.
+type LogHandler struct {
+    sink   *logSink
+    opts   slog.HandlerOptions
+    attrs  []LogAttr // From WithAttrs
+    groups []string  // From WithGroup
+}
+
+// NewLogHandler returns a handler for the log b that sends through p; nil
+// opts logs info and above
+func NewLogHandler(b LogBuffer, p Sender, opts *slog.HandlerOptions) *LogHandler {
+    h := &LogHandler{sink: newLogSink(b, p)}
+    if opts != nil {
+        h.opts = *opts
+    }
+    return h
+}
+
+// Enabled reports whether records of level are logged
+func (h *LogHandler) Enabled(_ context.Context, level slog.Level) bool {
+    floor := slog.LevelInfo
+    if h.opts.Level != nil {
+        floor = h.opts.Level.Level()
+    }
+    return level >= floor
+}
+
+// Handle sends the record to the log
+func (h *LogHandler) Handle(_ context.Context, r slog.Record) error {
+    line := LogLine{
+        Time:  r.Time,
+        Level: LogLevel(r.Level),
+        Text:  r.Message,
+        Attrs: h.attrs[:len(h.attrs):len(h.attrs)],
+    }
+    r.Attrs(func(a slog.Attr) bool {
+        line.Attrs = h.appendAttr(line.Attrs, h.groups, a)
+        return true
+    })
+    if h.opts.AddSource && r.PC != 0 {
+        f, _ := runtime.CallersFrames([]uintptr{r.PC}).Next()
+        line.Source = filepath.Base(f.File) + ":" + strconv.Itoa(f.Line)
+    }
+    h.sink.push(line)
+    return nil
+}
+
+// Close stops h, and the handlers derived from it, delivering records once
+// the queued ones are sent; later records are dropped
+func (h *LogHandler) Close() error {
+    h.sink.close()
+    return nil
+}
+
+// WithAttrs returns a handler that adds attrs to every record
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+    c := *h
+    c.attrs = h.attrs[:len(h.attrs):len(h.attrs)]
+    for _, a := range attrs {
+        c.attrs = h.appendAttr(c.attrs, h.groups, a)
+    }
+    return &c
+}
+
+// WithGroup returns a handler that qualifies later attrs with name
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+    if name == "" {
+        return h
+    }
+    c := *h
+    c.groups = append(h.groups[:len(h.groups):len(h.groups)], name)
+    return &c
+}
+
+// appendAttr flattens a into key=value pairs, groups joined with dots
+func (h *LogHandler) appendAttr(attrs []LogAttr, groups []string, a slog.Attr) []LogAttr {
+    a.Value = a.Value.Resolve()
+    if a.Value.Kind() == slog.KindGroup {
+        if a.Key != "" {
+            groups = append(groups[:len(groups):len(groups)], a.Key)
+        }
+        for _, ga := range a.Value.Group() {
+            attrs = h.appendAttr(attrs, groups, ga)
+        }
+        return attrs
+    }
+    if h.opts.ReplaceAttr != nil {
+        a = h.opts.ReplaceAttr(groups, a)
+        a.Value = a.Value.Resolve()
+    }
+    if a.Equal(slog.Attr{}) {
+        return attrs
+    }
+    key := strings.Join(append(groups[:len(groups):len(groups)], a.Key), ".")
+    return append(attrs, LogAttr{Key: key, Value: attrValue(a.Value)})
+}
+
+// attrValue quotes values that would not read back as a single token
+func attrValue(v slog.Value) string {
+    s := v.String()
+    if s == "" || strings.ContainsAny(s, " =\"\t\n") {
+        return strconv.Quote(s)
+    }
+    return s
+}
@@ -0,0 +1,169 @@
+package organisms
+
+import (
+    "bytes"
+    "errors"
+    "io"
+    "os"
+    "path/filepath"
+    "strings"
+    "time"
+
+    tea "github.com/charmbracelet/bubbletea"
+)
+
+// tailPoll is how often TailFile checks the file for more
+const tailPoll = 250 * time.Millisecond
+
+// tailBackfill is how much of the end of the file TailFile shows first,
+// like the last lines tail -f prints
+const tailBackfill = 64 << 10
+
+// tailChunk caps how much is read per check, so a fast writer cannot stall
+// the program; the rest is read straight after
+const tailChunk = 1 << 20
+
+// logTailMsg carries the lines a TailFile check read
+type logTailMsg struct {
+    id    string
+    tail  *fileTail
+    lines []LogLine
+}
+
+// TailFile follows the file at path like tail -F, streaming its lines into
+// the log b with the file name as their source. It starts near the end of
+// the file and keeps going when the file is truncated, or replaced as log
+// rotation does, and waits for it when it does not exist yet.
+func TailFile(b LogBuffer, path string) tea.Cmd {
+    t := &fileTail{path: path, source: filepath.Base(path)}
+    return func() tea.Msg {
+        return logTailMsg{id: b.id, tail: t, lines: t.poll()}
+    }
+}
+
+// fileTail is the state of one TailFile, handed from check to check
+type fileTail struct {
+    path    string
+    source  string
+    f       *os.File
+    info    os.FileInfo
+    off     int64  // Where the next read starts
+    partial []byte // Text after the last newline
+    skip    bool   // Drop the first line read, it was started part way in
+    buf     []byte
+    more    bool // The last read was cut short at tailChunk
+    failed  string // Last error shown, so it is not repeated every check
+}
+
+// next schedules the check after this one
+func (t *fileTail) next(id string) tea.Cmd {
+    check := func() tea.Msg { return logTailMsg{id: id, tail: t, lines: t.poll()} }
+    if t.more {
+        return check
+    }
+    return tea.Tick(tailPoll, func(time.Time) tea.Msg { return check() })
+}
+
+// poll reads what was written since the last check
+func (t *fileTail) poll() []LogLine {
+    info, err := os.Stat(t.path)
+    switch {
+    case err != nil:
+        lines := t.read() // What the writer got in before it went away
+        if t.f != nil && errors.Is(err, os.ErrNotExist) {
+            return lines // Rotated away; the new file turns up later
+        }
+        return append(lines, t.note(LevelWarn, err.Error())...)
+    case t.f != nil && !os.SameFile(info, t.info):
+        lines := append(t.read(), t.flush()...)
+        t.close()
+        lines = append(lines, t.note(LevelInfo, t.path+" was replaced, following the new file")...)
+        if err := t.open(info, 0); err != nil {
+            return append(lines, t.note(LevelWarn, err.Error())...)
+        }
+        return append(lines, t.read()...)
+    case t.f == nil:
+        if err := t.open(info, max(info.Size()-tailBackfill, 0)); err != nil {
+            return t.note(LevelWarn, err.Error())
+        }
+    case info.Size() < t.off:
+        t.off, t.partial, t.skip = 0, nil, false
+        lines := t.note(LevelInfo, t.path+" was truncated")
+        return append(lines, t.read()...)
+    }
+    return t.read()
+}
+
+// open starts reading the file at off. A start part way in skips ahead to
+// the next whole line.
+func (t *fileTail) open(info os.FileInfo, off int64) error {
+    f, err := os.Open(t.path)
+    if err != nil {
+        return err
+    }
+    t.f, t.info, t.off, t.partial, t.failed = f, info, off, nil, ""
+    t.skip = off > 0
+    return nil
+}
+
+func (t *fileTail) close() {
+    if t.f != nil {
+        t.f.Close()
+    }
+    t.f, t.partial = nil, nil
+}
+
+// read returns the whole lines written since the last read
+func (t *fileTail) read() []LogLine {
+    t.more = false
+    if t.f == nil {
+        return nil
+    }
+    if t.buf == nil {
+        t.buf = make([]byte, tailChunk)
+    }
+    n, err := t.f.ReadAt(t.buf, t.off)
+    if err != nil && err != io.EOF {
+        return t.note(LevelWarn, err.Error())
+    }
+    t.off += int64(n)
+    t.more = n == len(t.buf)
+
+    data := append(t.partial, t.buf[:n]...)
+    end := bytes.LastIndexByte(data, '\n')
+    if end < 0 {
+        t.partial = data
+        return nil
+    }
+    t.partial = append([]byte(nil), data[end+1:]...)
+
+    now := time.Now()
+    var lines []LogLine
+    for _, text := range strings.Split(string(data[:end]), "\n") {
+        if t.skip {
+            t.skip = false
+            continue
+        }
+        lines = append(lines, LogLine{Time: now, Level: LevelInfo, Source: t.source, Text: strings.TrimSuffix(text, "\r")})
+    }
+    return lines
+}
+
+// flush returns the unterminated last line of a file being let go of
+func (t *fileTail) flush() []LogLine {
+    if len(t.partial) == 0 {
+        return nil
+    }
+    return []LogLine{{Time: time.Now(), Level: LevelInfo, Source: t.source, Text: string(t.partial)}}
+}
+
+// note is a line about the tail itself, shown once per distinct message
+func (t *fileTail) note(level LogLevel, text string) []LogLine {
+    if level == LevelWarn {
+        if text == t.failed {
+            return nil
+        }
+        t.failed = text
+    }
+    return []LogLine{{Time: time.Now(), Level: level, Source: t.source, Text: "tail: " + text}}
+}
@@ -1,28 +1,663 @@
 package organisms
 
 import (
-    "fmt"
-    "github.com/charmbracelet/bubbles/viewport"
+    "sort"
+    "strconv"
+    "strings"
+    "time"
+
+    "github.com/charmbracelet/bubbles/textinput"
+    tea "github.com/charmbracelet/bubbletea"
     "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
     "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
 )
 
-func NewLogViewport(width, height int) viewport.Model {
-    vp := viewport.New(width, height)
-    vp.Style = lipgloss.NewStyle().
+// DefaultLogLimit is how many lines a LogBuffer keeps unless told otherwise
+const DefaultLogLimit = 10000
+
+// logSourceMax caps the width of the source column
+const logSourceMax = 12
+
+// LogLine is one entry of a LogBuffer
+type LogLine struct {
+    Time   time.Time
+    Level  LogLevel
+    Source string // Component that wrote the line, shown in a dim column
+    Text   string
+    Attrs  []LogAttr // Drawn after the text as key=value pairs
+}
+
+// LogBuffer is a scrollable log that keeps the newest lines up to a limit,
+// dropping the oldest as new ones arrive. Only the lines on screen are drawn,
+// so appending stays cheap however long the log gets. It follows the tail like
+// tail -f until the user scrolls up, then counts the lines that arrive in a
+// pill that jumps back down; F toggles following and p pauses. / searches,
+// with n and N stepping through the matches, 1-4 show or hide each level and t
+// toggles the time and source columns. Long lines are cut off and scroll
+// sideways with left and right, or wrap once w is pressed. v selects lines,
+// extended with the motion keys, and y copies them. s saves the lines shown,
+// or the matches while searching, to a file and S saves them all. JSON lines
+// are read into a message and key=value attrs, nested objects collapsed until
+// o expands them. Pushed text is cleaned of escape sequences and control
+// characters as SetAnsiMode says. LogWriter streams anything written to an
+// io.Writer into it and TailFile follows a file. SetMinimap adds a map of
+// where the warnings and errors are, stepped through with ] and [.
+//
+// Lines are numbered from the first ever pushed, so the numbers held in
+// shown and matches stay valid as old lines drop off.
+type LogBuffer struct {
+    lines       []LogLine // Ring, oldest at head once full
+    head        int
+    dropped     int // Lines dropped so far, the number of the oldest held
+    limit       int
+    ansi        AnsiMode
+    parseJSON   bool      // Read JSON object lines into attrs
+    expand      bool      // Draw nested objects field by field
+    wrap        bool      // Wrap long lines instead of cutting them off
+    xoff        int       // Cells scrolled sideways while not wrapping
+    visual      bool      // Selecting lines to copy
+    anchor      int       // Number of the line the selection started on
+    caret       int       // Number of the line the selection was moved to
+    paused      bool      // Hold pushed lines back, see Pause
+    held        []LogLine // Lines pushed while paused
+    exportName  string
+    minimap     bool    // Draw the severity map down the right edge
+    shown       []int   // Numbers of the lines the level filter lets through
+    warns       []int   // Numbers of the shown warnings, for the minimap
+    errs        []int   // Numbers of the shown errors, for the minimap
+    hidden      [4]bool // Levels switched off, by levelIndex
+    levelSeq    int     // Numbers level key presses, see logLevelKeyMsg
+    offset      int     // First position in shown on screen
+    meta        bool    // Draw the time and source columns
+    timeFormat  string
+    sourceWidth int  // Widest source pushed, capped at logSourceMax
+    follow      bool // Keep the newest line on screen
+    unseen      int  // Lines pushed since following stopped
+    search      textinput.Model
+    searching   bool   // Search input has the keyboard
+    query       string // Lowercased search text
+    matches     []int  // Numbers of shown lines holding the query
+    match       int    // Current position in matches, -1 for none
+    width       int    // Outer size, border included
+    height      int
+    focused     bool
+    nav         VimNav
+    id          string
+}
+
+// NewLogBuffer returns an empty log of the given outer size
+func NewLogBuffer(width, height int) LogBuffer {
+    return LogBuffer{
+        limit:      DefaultLogLimit,
+        timeFormat: "15:04:05",
+        exportName: "log",
+        parseJSON:  true,
+        follow:     true,
+        search:     newSearchInput(),
+        match:      -1,
+        width:      width,
+        height:     height,
+        id:         zone.NewID("log"),
+    }
+}
+
+// SetLimit changes how many lines are kept, dropping the oldest if needed
+func (b *LogBuffer) SetLimit(n int) {
+    lines := b.Lines()
+    if n = max(n, 1); len(lines) > n {
+        b.dropped += len(lines) - n
+        lines = lines[len(lines)-n:]
+    }
+    b.lines, b.head, b.limit = lines, 0, n
+    b.refilter()
+}
+
+// SetSize sets the outer size of the log, border included
+func (b *LogBuffer) SetSize(width, height int) {
+    b.width, b.height = width, height
+    b.offset = clamp(b.offset, 0, b.maxOffset())
+}
+
+func (b *LogBuffer) Focus() {
+    b.focused = true
+}
+
+func (b *LogBuffer) Blur() {
+    b.focused = false
+}
+
+func (b LogBuffer) Focused() bool {
+    return b.focused
+}
+
+// SetShowMeta shows or hides the time and source columns
+func (b *LogBuffer) SetShowMeta(on bool) {
+    b.meta = on
+}
+
+// ShowMeta reports whether the time and source columns are drawn
+func (b LogBuffer) ShowMeta() bool {
+    return b.meta
+}
+
+// SetTimeFormat sets the time layout of the time column; "" leaves the time
+// out and shows only the source
+func (b *LogBuffer) SetTimeFormat(layout string) {
+    b.timeFormat = layout
+}
+
+// Append adds text to the log at info level, one line per line of text
+func (b *LogBuffer) Append(text string) {
+    b.AppendLevel(LevelInfo, text)
+}
+
+// AppendLevel adds text to the log at level, one line per line of text
+func (b *LogBuffer) AppendLevel(level LogLevel, text string) {
+    now := time.Now()
+    for _, l := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+        b.Push(LogLine{Time: now, Level: level, Text: l})
+    }
+}
+
+// Push adds one line to the log, cleaned up according to the AnsiMode
+func (b *LogBuffer) Push(line LogLine) {
+    if b.paused {
+        b.hold(line)
+        return
+    }
+    if b.parseJSON {
+        // Before cleaning, as JSON escapes can spell out control characters
+        line, _ = parseJSONLine(line)
+    }
+    line.Text = sanitize(line.Text, b.ansi)
+    line.Source = sanitize(line.Source, AnsiStrip)
+    line.Attrs = sanitizeAttrs(line.Attrs)
+    b.sourceWidth = min(max(b.sourceWidth, lipgloss.Width(line.Source)), logSourceMax)
+    if len(b.lines) < b.limit {
+        b.lines = append(b.lines, line)
+    } else {
+        b.lines[b.head] = line
+        b.head = (b.head + 1) % len(b.lines)
+        b.dropped++
+        b.forgetDropped()
+        b.forgetSelected()
+    }
+    if b.hidden[levelIndex(line.Level)] {
+        return
+    }
+
+    seq := b.dropped + len(b.lines) - 1
+    b.shown = append(b.shown, seq)
+    b.trackProblem(seq, line.Level)
+    b.trackMatch(seq)
+    if b.follow {
+        b.offset = b.maxOffset()
+    } else {
+        b.unseen++
+    }
+}
+
+// sanitizeAttrs strips escapes from attrs, copying them so the caller's
+// slice is left alone
+func sanitizeAttrs(attrs []LogAttr) []LogAttr {
+    if attrs == nil {
+        return nil
+    }
+    clean := make([]LogAttr, len(attrs))
+    for i, a := range attrs {
+        clean[i] = LogAttr{Key: sanitize(a.Key, AnsiStrip), Value: sanitize(a.Value, AnsiStrip), Fields: sanitizeAttrs(a.Fields)}
+    }
+    return clean
+}
+
+// forgetDropped lets go of the shown line and match that just dropped off
+func (b *LogBuffer) forgetDropped() {
+    if len(b.shown) > 0 && b.shown[0] < b.dropped {
+        b.shown = b.shown[1:]
+        if b.offset > 0 {
+            b.offset-- // Keep the same lines on screen
+        }
+    }
+    if len(b.warns) > 0 && b.warns[0] < b.dropped {
+        b.warns = b.warns[1:]
+    }
+    if len(b.errs) > 0 && b.errs[0] < b.dropped {
+        b.errs = b.errs[1:]
+    }
+    if len(b.matches) > 0 && b.matches[0] < b.dropped {
+        b.matches = b.matches[1:]
+        if b.match--; b.match < 0 && len(b.matches) > 0 {
+            b.match = 0
+        }
+    }
+}
+
+// refilter rebuilds shown after the level filter or the lines changed,
+// keeping the top line on screen where it can
+func (b *LogBuffer) refilter() {
+    top := b.dropped
+    if b.offset < len(b.shown) {
+        top = b.shown[b.offset]
+    }
+    shown := make([]int, 0, len(b.lines))
+    b.warns, b.errs = nil, nil
+    for i := range b.lines {
+        if level := b.Line(i).Level; !b.hidden[levelIndex(level)] {
+            shown = append(shown, b.dropped+i)
+            b.trackProblem(b.dropped+i, level)
+        }
+    }
+    b.shown = shown
+    b.offset = clamp(sort.SearchInts(shown, top), 0, b.maxOffset())
+    if b.follow {
+        b.offset = b.maxOffset()
+    }
+    b.findMatches()
+}
+
+// Clear empties the log
+func (b *LogBuffer) Clear() {
+    b.dropped += len(b.lines)
+    b.lines, b.head, b.shown, b.offset, b.unseen = nil, 0, nil, 0, 0
+    b.warns, b.errs = nil, nil
+    b.matches, b.match = nil, -1
+    b.visual, b.held = false, nil
+}
+
+// Len is the number of lines held
+func (b LogBuffer) Len() int {
+    return len(b.lines)
+}
+
+// Line returns the i-th line held, 0 being the oldest
+func (b LogBuffer) Line(i int) LogLine {
+    return b.lines[(b.head+i)%len(b.lines)]
+}
+
+// Lines returns the lines held, oldest first
+func (b LogBuffer) Lines() []LogLine {
+    return append(append([]LogLine(nil), b.lines[b.head:]...), b.lines[:b.head]...)
+}
+
+// line returns the line numbered seq
+func (b LogBuffer) line(seq int) LogLine {
+    return b.Line(seq - b.dropped)
+}
+
+// AtBottom reports whether the newest line is on screen
+func (b LogBuffer) AtBottom() bool {
+    return b.offset >= b.maxOffset()
+}
+
+// GotoBottom scrolls to the newest line and follows the tail again
+func (b *LogBuffer) GotoBottom() {
+    b.offset = b.maxOffset()
+    b.follow, b.unseen = true, 0
+}
+
+// ScrollTo puts the i-th shown line at the top of the log, as far as it can
+// scroll.
+// Following stops unless that leaves the newest line on screen.
+func (b *LogBuffer) ScrollTo(i int) {
+    b.offset = clamp(i, 0, b.maxOffset())
+    if b.follow = b.AtBottom(); b.follow {
+        b.unseen = 0
+    }
+}
+
+// SetFollow turns following the tail on, jumping to it, or off
+func (b *LogBuffer) SetFollow(on bool) {
+    if on {
+        b.GotoBottom()
+        return
+    }
+    b.follow = false
+}
+
+// Following reports whether new lines scroll the log
+func (b LogBuffer) Following() bool {
+    return b.follow
+}
+
+// Unseen is the number of lines that arrived since following stopped
+func (b LogBuffer) Unseen() int {
+    return b.unseen
+}
+
+// innerSize is the area inside the border and padding, less the minimap
+func (b LogBuffer) innerSize() (int, int) {
+    w := b.width - 4
+    if b.minimap {
+        w--
+    }
+    return max(w, 1), max(b.height-2, 1)
+}
+
+func (b LogBuffer) maxOffset() int {
+    w, h := b.innerSize()
+    if !b.wrap {
+        return max(len(b.shown)-h, 0)
+    }
+    // As far down as still fills the screen; a line taller than the screen
+    // can still be scrolled to
+    p, used := len(b.shown), 0
+    for p > 0 {
+        n := b.rowsOf(b.line(b.shown[p-1]), w)
+        if used+n > h {
+            break
+        }
+        used += n
+        p--
+    }
+    return min(p, max(len(b.shown)-1, 0))
+}
+
+func (b LogBuffer) Update(msg tea.Msg) (LogBuffer, tea.Cmd) {
+    _, h := b.innerSize()
+    switch msg := msg.(type) {
+    case logLevelKeyMsg:
+        b.levelKey(msg)
+    case LogMsg:
+        if msg.ID == b.id {
+            b.Push(msg.Line)
+        }
+    case logTailMsg:
+        if msg.id != b.id {
+            return b, nil
+        }
+        for _, l := range msg.lines {
+            b.Push(l)
+        }
+        return b, msg.tail.next(b.id)
+    case tea.KeyMsg:
+        switch {
+        case !b.focused:
+            return b, nil
+        case b.searching:
+            return b.updateSearch(msg)
+        case b.visual:
+            if cmd, ok := b.updateSelect(msg); ok {
+                return b, cmd
+            }
+        case msg.String() == "v":
+            b.StartSelection()
+            return b, nil
+        case msg.String() == "/":
+            b.searching = true
+            return b, b.search.Focus()
+        case b.query != "" && msg.String() == "n":
+            b.NextMatch(1)
+            return b, nil
+        case b.query != "" && msg.String() == "N":
+            b.NextMatch(-1)
+            return b, nil
+        case b.query != "" && msg.String() == "esc":
+            b.SetSearch("")
+            return b, nil
+        }
+        if d := levelDigit(msg); d > 0 && b.nav.Pending() == 0 && !b.visual {
+            return b, b.startLevelKey(msg, d)
+        }
+        if to, ok := b.nav.Move(msg, b.offset, len(b.shown), h); ok {
+            b.ScrollTo(to)
+            return b, nil
+        }
+        switch msg.String() {
+        case "up", "k":
+            b.ScrollTo(b.offset - 1)
+        case "down", "j":
+            b.ScrollTo(b.offset + 1)
+        case "pgup", "b":
+            b.ScrollTo(b.offset - h)
+        case "pgdown", "f":
+            b.ScrollTo(b.offset + h)
+        case "home":
+            b.ScrollTo(0)
+        case "end":
+            b.GotoBottom()
+        case "F":
+            b.SetFollow(!b.follow)
+        case "p":
+            if b.paused {
+                b.Resume()
+            } else {
+                b.Pause()
+            }
+        case "left", "h":
+            b.ScrollX(-logScrollStep)
+        case "right", "l":
+            b.ScrollX(logScrollStep)
+        case "w":
+            b.SetWrap(!b.wrap)
+        case "]":
+            b.NextProblem(1)
+        case "[":
+            b.NextProblem(-1)
+        case "s":
+            return b, b.Export(b.saveScope())
+        case "S":
+            return b, b.Export(LogAll)
+        case "o":
+            b.expand = !b.expand
+        case "t":
+            b.meta = !b.meta
+        }
+    case tea.MouseMsg:
+        if msg.Action != tea.MouseActionPress || !zone.Get(b.id).InBounds(msg) {
+            return b, nil
+        }
+        switch msg.Button {
+        case tea.MouseButtonLeft:
+            x, y := zone.Get(b.id).Pos(msg)
+            switch {
+            case b.minimap && x == b.width-3 && y >= 1 && y <= h:
+                b.jumpMap(y - 1)
+            case !zone.Get(b.id + ":pill").InBounds(msg):
+            case b.paused:
+                b.Resume()
+            default:
+                b.GotoBottom()
+            }
+        case tea.MouseButtonWheelUp:
+            b.ScrollTo(b.offset - 3)
+        case tea.MouseButtonWheelDown:
+            b.ScrollTo(b.offset + 3)
+        }
+    }
+    return b, nil
+}
+
+func (b LogBuffer) View() string {
+    w, h := b.innerSize()
+    rows := make([]string, 0, h)
+    current := -1
+    if b.match >= 0 {
+        current = b.matches[b.match]
+    }
+    for p := b.offset; p < len(b.shown) && len(rows) < h; p++ {
+        seq := b.shown[p]
+        rows = append(rows, b.rowsAt(seq, current, w)...)
+    }
+    rows = rows[:min(len(rows), h)]
+    for p := b.offset - 1; p >= 0 && len(rows) < h; p-- {
+        // Wrapped lines left room at the bottom; fill it with the end of the
+        // lines above rather than leave it blank
+        seq := b.shown[p]
+        above := b.rowsAt(seq, current, w)
+        rows = append(above[max(len(above)-(h-len(rows)), 0):], rows...)
+    }
+    for len(rows) < h {
+        rows = append(rows, strings.Repeat(" ", w))
+    }
+    if b.searching {
+        in := b.search
+        in.Width = max(w-2, 1)
+        rows[h-1] = fitCell(in.View(), w)
+    } else if pill := b.pillView(); pill != "" {
+        pw := lipgloss.Width(pill)
+        rows[h-1] = atoms.Truncate(rows[h-1], max(w-pw, 0), "") + pill
+    }
+    if b.minimap {
+        for k, cell := range b.minimapView(h) {
+            rows[k] += cell
+        }
+    }
+
+    border := theme.Border
+    if b.focused {
+        border = theme.Primary
+    }
+    box := lipgloss.NewStyle().
         Border(lipgloss.NormalBorder()).
-        BorderForeground(theme.Border).
-        Padding(0, 1)
+        BorderTop(false).
+        BorderForeground(border).
+        Padding(0, 1).
+        Render(strings.Join(rows, "\n"))
+    return zone.Mark(b.id, borderTitle(b.title(), lipgloss.Width(box), border)+"\n"+box)
+}
+
+// rowsAt draws the line seq, picked out when it is the current match or
+// selected
+func (b LogBuffer) rowsAt(seq, current, width int) []string {
+    rows := b.lineView(b.line(seq), seq == current, width)
+    if b.selected(seq) {
+        for i, r := range rows {
+            rows[i] = selectedView(r)
+        }
+    }
+    return rows
+}
+
+// lineView draws one line as the rows it takes within width cells: time
+// and source when shown, then the level tag and the text, which is either
+// scrolled sideways or wrapped under itself
+func (b LogBuffer) lineView(l LogLine, current bool, width int) []string {
+    style := lipgloss.NewStyle()
+    if current {
+        style = style.Background(theme.Surface)
+    }
+    prefix := b.prefixView(l, style)
+    bw := max(width-lipgloss.Width(prefix), 1)
+    if !b.wrap {
+        return []string{prefix + atoms.Cut(b.bodyView(l, style, b.xoff+bw), b.xoff, bw)}
+    }
 
-    vp.SetContent("System initialized.\\nListening for Gnostic signals...\\n")
-    return vp
+    n := b.rowsOf(l, width)
+    body := b.bodyView(l, style, n*bw)
+    indent := style.Render(strings.Repeat(" ", lipgloss.Width(prefix)))
+    rows := make([]string, n)
+    for k := range rows {
+        lead := indent
+        if k == 0 {
+            lead = prefix
+        }
+        rows[k] = lead + atoms.Cut(body, k*bw, bw)
+    }
+    return rows
 }
 
-func AppendLog(vp *viewport.Model, msg string) {
-    oldContent := vp.View()
-    // A simple simulation of log appending. 
-    // In a real app, you'd maintain a buffer.
-    newContent := fmt.Sprintf("%s\\n> %s", oldContent, msg)
-    vp.SetContent(newContent)
-    vp.GotoBottom()
-}
\ No newline at end of file
+// prefixView is the time, source and level tag that start a line
+func (b LogBuffer) prefixView(l LogLine, style lipgloss.Style) string {
+    return b.metaView(l, style) + style.Copy().Foreground(levelColor(l.Level)).Bold(true).Render(l.Level.tag()+" ")
+}
+
+// bodyView paints the text and attrs of a line within width cells
+func (b LogBuffer) bodyView(l LogLine, style lipgloss.Style, width int) string {
+    text := style.Copy().Foreground(levelTextColor(l.Level))
+    if len(l.Attrs) > 0 {
+        // The text at its own width, then the attrs in what is left
+        tw := min(lipgloss.Width(l.Text), width)
+        return b.textView(l.Text, text, tw) + paintRendered(b.attrsView(l.Attrs, style), style, width-tw)
+    }
+    return b.textView(l.Text, text, width)
+}
+
+// textView paints the text of a line with its search matches
+func (b LogBuffer) textView(s string, style lipgloss.Style, width int) string {
+    if strings.Contains(s, "\x1b") {
+        return paintRendered(s, style, width) // Colors kept by AnsiPreserve
+    }
+    return paintCell(s, b.searchMarks(s), style, width)
+}
+
+// attrsView draws a line's attrs, keys in the accent color and nested
+// objects collapsed to their field count unless expanded
+func (b LogBuffer) attrsView(attrs []LogAttr, style lipgloss.Style) string {
+    key := style.Copy().Foreground(theme.Accent)
+    value := style.Copy().Foreground(theme.Text)
+    dim := style.Copy().Foreground(theme.Subtext)
+    if b.expand {
+        attrs = flatAttrs(attrs, "")
+    }
+    var s strings.Builder
+    for _, a := range attrs {
+        s.WriteString(style.Render(" ") + key.Render(a.Key) + dim.Render("="))
+        if a.Fields != nil {
+            s.WriteString(dim.Render("{…" + strconv.Itoa(len(a.Fields)) + "}"))
+        } else {
+            s.WriteString(value.Render(a.Value))
+        }
+    }
+    return s.String()
+}
+
+// metaView is the dim time and source columns of a line, "" while hidden
+func (b LogBuffer) metaView(l LogLine, style lipgloss.Style) string {
+    if !b.meta {
+        return ""
+    }
+    var meta string
+    if b.timeFormat != "" {
+        meta = l.Time.Format(b.timeFormat) + " "
+    }
+    if b.sourceWidth > 0 {
+        meta += fitCell(l.Source, b.sourceWidth) + " "
+    }
+    return style.Copy().Foreground(theme.Subtext).Render(meta)
+}
+
+// title is the text set into the top border: search and level filter state
+func (b LogBuffer) title() string {
+    var parts []string
+    for _, t := range []string{b.selectTitle(), b.searchTitle(), b.levelTitle()} {
+        if t != "" {
+            parts = append(parts, t)
+        }
+    }
+    return strings.Join(parts, theme.Caption.Render(" • "))
+}
+
+// pillView is the "↓ 37 new lines" marker shown while lines arrive out of
+// sight, or ""
+func (b LogBuffer) pillView() string {
+    if b.paused {
+        return b.pausePill()
+    }
+    if b.follow || b.unseen == 0 {
+        return ""
+    }
+    text := "↓ " + strconv.Itoa(b.unseen) + " new line"
+    if b.unseen > 1 {
+        text += "s"
+    }
+    pill := lipgloss.NewStyle().
+        Foreground(lipgloss.Color("229")).
+        Background(theme.Primary).
+        Padding(0, 1).
+        Render(text)
+    return zone.Mark(b.id+":pill", pill)
+}
+
+// borderTitle draws the top edge of a normal border width cells wide with
+// title set into it, e.g. "┌─ /err 3 of 17 ───┐"
+func borderTitle(title string, width int, color lipgloss.TerminalColor) string {
+    edge := lipgloss.NewStyle().Foreground(color)
+    if title == "" || width < 6 {
+        return edge.Render("┌" + strings.Repeat("─", max(width-2, 0)) + "┐")
+    }
+    title = atoms.Truncate(title, width-6, "…")
+    fill := max(width-5-lipgloss.Width(title), 0)
+    return edge.Render("┌─ ") + title + edge.Render(" "+strings.Repeat("─", fill)+"┐")
+}
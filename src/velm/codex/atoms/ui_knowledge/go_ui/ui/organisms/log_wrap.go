@@ -0,0 +1,69 @@
+package organisms
+
+import (
+    "github.com/charmbracelet/lipgloss"
+)
+
+// logScrollStep is how many cells left and right scroll a log sideways
+const logScrollStep = 8
+
+// SetWrap wraps long lines under themselves, or with false cuts them off
+// at the edge to be scrolled sideways, which keeps stack traces and JSON
+// lines readable
+func (b *LogBuffer) SetWrap(on bool) {
+    b.wrap, b.xoff = on, 0
+    b.offset = clamp(b.offset, 0, b.maxOffset())
+    if b.follow {
+        b.offset = b.maxOffset()
+    }
+}
+
+// Wrapping reports whether long lines wrap
+func (b LogBuffer) Wrapping() bool {
+    return b.wrap
+}
+
+// ScrollX scrolls the lines on screen sideways by delta cells, no further
+// than to show the end of the longest; it does nothing while wrapping
+func (b *LogBuffer) ScrollX(delta int) {
+    if b.wrap {
+        return
+    }
+    b.xoff = clamp(b.xoff+delta, 0, b.maxScrollX())
+}
+
+// OffsetX is how many cells the log is scrolled sideways
+func (b LogBuffer) OffsetX() int {
+    return b.xoff
+}
+
+// maxScrollX is how far the longest line on screen can scroll
+func (b LogBuffer) maxScrollX() int {
+    w, h := b.innerSize()
+    widest := 0
+    for p := b.offset; p < len(b.shown) && p < b.offset+h; p++ {
+        l := b.line(b.shown[p])
+        widest = max(widest, b.bodyWidth(l)-b.bodyRoom(l, w))
+    }
+    return widest
+}
+
+// rowsOf is how many rows the line l wraps to within width cells
+func (b LogBuffer) rowsOf(l LogLine, width int) int {
+    bw := b.bodyRoom(l, width)
+    return max((b.bodyWidth(l)+bw-1)/bw, 1)
+}
+
+// bodyRoom is the width left for the text of l after its prefix
+func (b LogBuffer) bodyRoom(l LogLine, width int) int {
+    return max(width-lipgloss.Width(b.prefixView(l, lipgloss.NewStyle())), 1)
+}
+
+// bodyWidth is the full width of the text and attrs of l
+func (b LogBuffer) bodyWidth(l LogLine) int {
+    w := lipgloss.Width(l.Text)
+    if len(l.Attrs) > 0 {
+        w += lipgloss.Width(b.attrsView(l.Attrs, lipgloss.NewStyle()))
+    }
+    return w
+}
@@ -0,0 +1,154 @@
+package organisms
+
+import (
+    "bytes"
+    "io"
+    "strings"
+    "sync"
+    "time"
+
+    tea "github.com/charmbracelet/bubbletea"
+)
+
+// logWriterQueue is how many lines a LogWriter or LogHandler holds while the
+// program is busy; past it the oldest are dropped, as the log would drop them
+const logWriterQueue = DefaultLogLimit
+
+// logPartialMax is the longest unterminated line a LogWriter waits on before
+// passing it along anyway
+const logPartialMax = 64 << 10
+
+// Sender delivers messages to a running program; *tea.Program is one
+type Sender interface {
+    Send(msg tea.Msg)
+}
+
+// LogMsg adds Line to the LogBuffer whose ID matches
+type LogMsg struct {
+    ID   string
+    Line LogLine
+}
+
+// ID identifies the log for LogMsg
+func (b LogBuffer) ID() string {
+    return b.id
+}
+
+// LogWriter returns a writer whose lines stream into the log b at info
+// level, sent through p. Writes never wait on the program, so it is safe to
+// use from Update or from other goroutines, e.g. with log.SetOutput or as a
+// subprocess's Stdout. Lines queue up while the program is busy, the oldest
+// dropped past logWriterQueue. Each writer runs a goroutine to deliver them;
+// Close sends any unterminated line and ends it.
+func LogWriter(b LogBuffer, p Sender) io.WriteCloser {
+    return LogWriterFrom(b, p, "", LevelInfo)
+}
+
+// LogWriterFrom is LogWriter with the source and level its lines carry
+func LogWriterFrom(b LogBuffer, p Sender, source string, level LogLevel) io.WriteCloser {
+    return &logWriter{sink: newLogSink(b, p), source: source, level: level}
+}
+
+type logWriter struct {
+    mu      sync.Mutex
+    sink    *logSink
+    source  string
+    level   LogLevel
+    partial []byte // Text after the last newline
+}
+
+func (w *logWriter) Write(p []byte) (int, error) {
+    w.mu.Lock()
+    defer w.mu.Unlock()
+
+    w.partial = append(w.partial, p...)
+    for {
+        i := bytes.IndexByte(w.partial, '\n')
+        if i < 0 && len(w.partial) < logPartialMax {
+            break
+        }
+        if i < 0 {
+            i = len(w.partial)
+        }
+        w.emit(w.partial[:i])
+        w.partial = w.partial[min(i+1, len(w.partial)):]
+    }
+    return len(p), nil
+}
+
+func (w *logWriter) emit(line []byte) {
+    text := strings.TrimSuffix(string(line), "\r")
+    w.sink.push(LogLine{Time: time.Now(), Level: w.level, Source: w.source, Text: text})
+}
+
+// Close sends any unterminated line; later writes are dropped
+func (w *logWriter) Close() error {
+    w.mu.Lock()
+    defer w.mu.Unlock()
+
+    if len(w.partial) > 0 {
+        w.emit(w.partial)
+        w.partial = nil
+    }
+    w.sink.close()
+    return nil
+}
+
+// logSink queues lines for one LogBuffer and hands them to the program in
+// order, off the caller's goroutine so a write from inside Update cannot
+// deadlock on Send
+type logSink struct {
+    id    string
+    send  Sender
+    mu    sync.Mutex
+    queue  []LogLine
+    ready  chan struct{} // Holds a token while queue has lines
+    closed bool
+}
+
+func newLogSink(b LogBuffer, p Sender) *logSink {
+    s := &logSink{id: b.id, send: p, ready: make(chan struct{}, 1)}
+    go s.pump()
+    return s
+}
+
+// push queues a line without ever blocking; after close it drops it
+func (s *logSink) push(l LogLine) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if s.closed {
+        return
+    }
+    if len(s.queue) >= logWriterQueue {
+        s.queue = s.queue[1:]
+    }
+    s.queue = append(s.queue, l)
+    select {
+    case s.ready <- struct{}{}:
+    default: // The pump is already due to look
+    }
+}
+
+// close ends the pump once the queued lines are sent
+func (s *logSink) close() {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if !s.closed {
+        s.closed = true
+        close(s.ready)
+    }
+}
+
+func (s *logSink) pump() {
+    for range s.ready {
+        s.mu.Lock()
+        lines := s.queue
+        s.queue = nil
+        s.mu.Unlock()
+        for _, l := range lines {
+            s.send.Send(LogMsg{ID: s.id, Line: l})
+        }
+    }
+}
@@ -1,9 +1,12 @@
 package organisms
 
 import (
-    "strings"
+    "strconv"
+
+    tea "github.com/charmbracelet/bubbletea"
     "github.com/charmbracelet/lipgloss"
     "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
 )
 
 var (
@@ -28,35 +31,57 @@ var (
         BottomLeft:  "┴",
         BottomRight: "┴",
     }
+)
 
-    inactiveTab = lipgloss.NewStyle().
+// tabStyles resolves the tab styles against the active theme at render time
+func tabStyles() (inactive, active lipgloss.Style) {
+    inactive = lipgloss.NewStyle().
         Border(tabBorder, true).
         BorderForeground(theme.Border).
-        Padding(0, 1)
+        Padding(0, theme.Space(1))
 
-    activeTab = inactiveTab.Copy().
+    active = inactive.Copy().
         Border(activeTabBorder, true).
         BorderForeground(theme.Primary).
         Foreground(theme.Primary)
 
-    tabGap = lipgloss.NewStyle().
-        Border(lipgloss.Border{Bottom: "─"}, false, false, true, false).
-        BorderForeground(theme.Border).
-        Width(2)
-)
+    return theme.Derive(inactive), theme.Derive(active)
+}
+
+// TabZoneID is the mouse zone of the i-th tab rendered by RenderTabs
+func TabZoneID(i int) string {
+    return "tabs:" + strconv.Itoa(i)
+}
 
+// RenderTabs draws a tab bar width cells wide. Tabs that do not fit are
+// scrolled out of view behind ‹ and › markers, keeping the active tab shown.
 func RenderTabs(items []string, activeIndex int, width int) string {
-    var renderedTabs []string
+    return renderTabs(items, activeIndex, width, TabZoneID)
+}
+
+// renderTabs is RenderTabs with the zone of each tab given by zoneID; the
+// ‹ and › markers get zoneID(-1) and zoneID(len(items))
+func renderTabs(items []string, activeIndex int, width int, zoneID func(int) string) string {
+    inactiveTab, activeTab := tabStyles()
+    renderedTabs := make([]string, len(items))
 
     for i, item := range items {
+        style := inactiveTab
         if i == activeIndex {
-            renderedTabs = append(renderedTabs, activeTab.Render(item))
-        } else {
-            renderedTabs = append(renderedTabs, inactiveTab.Render(item))
+            style = activeTab
         }
+        renderedTabs[i] = zone.Mark(zoneID(i), style.Render(item))
     }
 
-    row := lipgloss.JoinHorizontal(lipgloss.Top, renderedTabs...)
+    first, last := tabWindow(renderedTabs, activeIndex, width)
+    shown := renderedTabs[first : last+1]
+    if first > 0 {
+        shown = append([]string{zone.Mark(zoneID(-1), tabScroller("‹"))}, shown...)
+    }
+    if last < len(items)-1 {
+        shown = append(shown, zone.Mark(zoneID(len(items)), tabScroller("›")))
+    }
+    row := lipgloss.JoinHorizontal(lipgloss.Top, shown...)
 
     // Fill remaining width with bottom border
     gapWidth := width - lipgloss.Width(row)
@@ -66,8 +91,206 @@ func RenderTabs(items []string, activeIndex int, width int) string {
             BorderForeground(theme.Border).
             Width(gapWidth).
             Render("")
-        row = lipgloss.JoinHorizontal(lipgloss.Top, row, gap)
+        row = lipgloss.JoinHorizontal(lipgloss.Bottom, row, gap) // Level with the tabs' feet
     }
 
     return row
-}
\ No newline at end of file
+}
+
+// tabWindow picks the run of tabs that fits width with room for the scroll
+// markers: as far left as still shows the active tab, then as far right as
+// fits. A width of 0 or less shows every tab.
+func tabWindow(tabs []string, active, width int) (first, last int) {
+    if len(tabs) == 0 {
+        return 0, -1
+    }
+    widths := make([]int, len(tabs))
+    total := 0
+    for i, t := range tabs {
+        widths[i] = lipgloss.Width(t)
+        total += widths[i]
+    }
+    if width <= 0 || total <= width {
+        return 0, len(tabs) - 1
+    }
+
+    active = clamp(active, 0, len(tabs)-1)
+    room := width - 2*tabScrollerWidth
+    used := widths[active]
+    first, last = active, active
+    for first > 0 && used+widths[first-1] <= room {
+        first--
+        used += widths[first]
+    }
+    for last < len(tabs)-1 && used+widths[last+1] <= room {
+        last++
+        used += widths[last]
+    }
+    return first, last
+}
+
+// tabScrollerWidth is the width of a ‹ or › marker
+const tabScrollerWidth = 2
+
+// tabScroller draws a scroll marker as tall as a tab, its foot continuing
+// the bar's bottom edge
+func tabScroller(arrow string) string {
+    mark := lipgloss.NewStyle().Foreground(theme.Subtext)
+    edge := lipgloss.NewStyle().Foreground(theme.Border)
+    return lipgloss.JoinVertical(lipgloss.Left, "  ", mark.Render(" "+arrow), edge.Render("──"))
+}
+
+// Tab is one entry of a Tabs bar
+type Tab struct {
+    ID       string // Names the tab in messages; the title when empty
+    Title    string
+    Closable bool // Draw a ✕ and let ctrl+w close it
+}
+
+// TabClosedMsg reports a tab the user closed
+type TabClosedMsg struct {
+    ID    string
+    Index int // Where the tab was before it closed
+}
+
+// Tabs is a tab bar that keeps its tabs and which one is active. tab and
+// shift+tab switch tabs, as do clicks on the ‹ and › shown when they
+// overflow, and ctrl+w or a click on its ✕ closes a closable one. Closing
+// the active tab activates its right neighbour, or the left one for the
+// last tab.
+type Tabs struct {
+    tabs   []Tab
+    active int // -1 once every tab is closed
+    width  int
+    id     string
+}
+
+// NewTabs returns a tab bar with the first tab active
+func NewTabs(tabs ...Tab) Tabs {
+    t := Tabs{active: -1, id: zone.NewID("tabs")}
+    for _, tab := range tabs {
+        t.Add(tab)
+    }
+    return t
+}
+
+// Add appends a tab, activating it if it is the only one
+func (t *Tabs) Add(tab Tab) {
+    if tab.ID == "" {
+        tab.ID = tab.Title
+    }
+    t.tabs = append(t.tabs[:len(t.tabs):len(t.tabs)], tab)
+    if t.active < 0 {
+        t.active = 0
+    }
+}
+
+// Tabs returns the tabs in order
+func (t Tabs) Tabs() []Tab {
+    return append([]Tab(nil), t.tabs...)
+}
+
+// Len is the number of tabs
+func (t Tabs) Len() int {
+    return len(t.tabs)
+}
+
+// Active returns the index of the active tab, -1 when there are none
+func (t Tabs) Active() int {
+    return t.active
+}
+
+// ActiveID returns the ID of the active tab, "" when there are none
+func (t Tabs) ActiveID() string {
+    if t.active < 0 {
+        return ""
+    }
+    return t.tabs[t.active].ID
+}
+
+// SetActive activates the i-th tab
+func (t *Tabs) SetActive(i int) {
+    if len(t.tabs) > 0 {
+        t.active = clamp(i, 0, len(t.tabs)-1)
+    }
+}
+
+// Step activates the tab delta places away, wrapping around the ends
+func (t *Tabs) Step(delta int) {
+    if n := len(t.tabs); n > 0 {
+        t.active = ((t.active+delta)%n + n) % n
+    }
+}
+
+// SetWidth sets the width the bar's bottom edge runs to
+func (t *Tabs) SetWidth(w int) {
+    t.width = w
+}
+
+// Close removes the i-th tab if closable and reports it with a TabClosedMsg
+func (t *Tabs) Close(i int) tea.Cmd {
+    if i < 0 || i >= len(t.tabs) || !t.tabs[i].Closable {
+        return nil
+    }
+    closed := t.tabs[i]
+    t.tabs = append(t.tabs[:i:i], t.tabs[i+1:]...)
+    switch {
+    case len(t.tabs) == 0:
+        t.active = -1
+    case i < t.active || t.active == len(t.tabs):
+        t.active-- // Keep the same tab, or fall back to the new last one
+    }
+    return func() tea.Msg { return TabClosedMsg{ID: closed.ID, Index: i} }
+}
+
+func (t Tabs) Update(msg tea.Msg) (Tabs, tea.Cmd) {
+    switch msg := msg.(type) {
+    case tea.KeyMsg:
+        switch msg.String() {
+        case "tab":
+            t.Step(1)
+        case "shift+tab":
+            t.Step(-1)
+        case "ctrl+w":
+            return t, t.Close(t.active)
+        }
+    case tea.MouseMsg:
+        switch {
+        case zone.Get(t.tabZone(-1)).Clicked(msg):
+            t.Step(-1)
+            return t, nil
+        case zone.Get(t.tabZone(len(t.tabs))).Clicked(msg):
+            t.Step(1)
+            return t, nil
+        }
+        for i := range t.tabs {
+            switch {
+            case zone.Get(t.closeZone(i)).Clicked(msg):
+                return t, t.Close(i)
+            case zone.Get(t.tabZone(i)).Clicked(msg):
+                t.active = i
+            }
+        }
+    }
+    return t, nil
+}
+
+func (t Tabs) View() string {
+    labels := make([]string, len(t.tabs))
+    closer := lipgloss.NewStyle().Foreground(theme.Subtext)
+    for i, tab := range t.tabs {
+        labels[i] = tab.Title
+        if tab.Closable {
+            labels[i] += " " + zone.Mark(t.closeZone(i), closer.Render("✕"))
+        }
+    }
+    return renderTabs(labels, t.active, t.width, t.tabZone)
+}
+
+func (t Tabs) tabZone(i int) string {
+    return t.id + ":" + strconv.Itoa(i)
+}
+
+func (t Tabs) closeZone(i int) string {
+    return t.id + ":close:" + strconv.Itoa(i)
+}
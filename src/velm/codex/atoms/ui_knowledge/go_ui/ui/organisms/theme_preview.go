@@ -0,0 +1,78 @@
+package organisms
+
+import (
+    "fmt"
+    "strings"
+
+    "github.com/charmbracelet/bubbles/table"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/atoms"
+    "gnostic-tui/ui/theme"
+)
+
+// ThemePreview renders every token of the active theme alongside sample components
+func ThemePreview() string {
+    section := func(title string, body string) string {
+        return lipgloss.JoinVertical(lipgloss.Left,
+            theme.Derive(theme.H2.Copy().MarginBottom(theme.Space(1))).Render(title),
+            body,
+        )
+    }
+
+    // Palette swatches, one token per line
+    var swatches []string
+    for _, tok := range theme.Current().Tokens() {
+        swatches = append(swatches, atoms.Swatch(tok.Color, fmt.Sprintf("%-10s", tok.Name)))
+    }
+
+    typography := lipgloss.JoinVertical(lipgloss.Left,
+        theme.H1.Render("Heading 1"),
+        theme.H2.Render("Heading 2"),
+        theme.Label.Render("Label"),
+        theme.Caption.Render("Caption"),
+    )
+
+    gap := strings.Repeat(" ", theme.Space(1))
+    badges := strings.Join([]string{
+        atoms.Badge("Info", atoms.BadgeInfo),
+        atoms.Badge("Success", atoms.BadgeSuccess, atoms.BadgeIcon()),
+        atoms.Badge("Warning", atoms.BadgeWarning, atoms.BadgeIcon()),
+        atoms.Badge("Danger", atoms.BadgeDanger, atoms.BadgeIcon(), atoms.BadgeRounded()),
+    }, gap)
+
+    active := atoms.NewButton("Active")
+    active.Active = true
+    buttons := lipgloss.JoinHorizontal(lipgloss.Top,
+        atoms.NewButton("Button").View(),
+        active.View(),
+    )
+
+    mini := table.New(
+        table.WithColumns([]table.Column{
+            {Title: "Name", Width: 12},
+            {Title: "State", Width: 8},
+        }),
+        table.WithRows([]table.Row{
+            {"genesis.py", "Active"},
+            {"void.rs", "Dormant"},
+        }),
+        table.WithHeight(3),
+        table.WithFocused(true),
+    )
+    mini.SetStyles(tableStyles())
+
+    left := section("Palette", strings.Join(swatches, "\n"))
+    right := lipgloss.JoinVertical(lipgloss.Left,
+        section("Typography", typography),
+        "",
+        section("Components", lipgloss.JoinVertical(lipgloss.Left, badges, "", buttons)),
+        "",
+        mini.View(),
+    )
+
+    return lipgloss.JoinHorizontal(lipgloss.Top,
+        left,
+        strings.Repeat(" ", theme.Space(4)),
+        right,
+    )
+}
@@ -0,0 +1,73 @@
+package organisms
+
+import (
+    "github.com/charmbracelet/bubbles/key"
+    "github.com/charmbracelet/bubbles/viewport"
+    tea "github.com/charmbracelet/bubbletea"
+)
+
+// VimNav reads vim-style motions over a list of lines: gg and G jump to the
+// ends, a count before either ("42G") jumps to that line, and ctrl+d/ctrl+u
+// move half a page. The keys come from Keys so apps can rebind them.
+type VimNav struct {
+    count int  // Digits typed so far
+    g     bool // First g of gg seen
+}
+
+// Move feeds a key to the motion in progress. Given the current line cur of
+// n lines and the page height, it returns the line to move to and whether the
+// key belonged to a motion; a motion still waiting for keys stays on cur.
+// Any other key drops a pending count or g.
+func (v *VimNav) Move(msg tea.KeyMsg, cur, n, page int) (int, bool) {
+    count, g := v.count, v.g
+    v.count, v.g = 0, false
+
+    if msg.Type == tea.KeyRunes && len(msg.Runes) == 1 {
+        if r := msg.Runes[0]; r >= '0' && r <= '9' && (r != '0' || count > 0) {
+            v.count = min(count*10+int(r-'0'), 1<<20)
+            return cur, true
+        }
+    }
+
+    switch {
+    case key.Matches(msg, Keys.Top):
+        if !g {
+            v.count, v.g = count, true
+            return cur, true
+        }
+        if count > 0 {
+            return clamp(count-1, 0, n-1), true
+        }
+        return 0, true
+    case key.Matches(msg, Keys.Bottom):
+        if count > 0 {
+            return clamp(count-1, 0, n-1), true
+        }
+        return max(n-1, 0), true
+    case key.Matches(msg, Keys.HalfPageDown):
+        return clamp(cur+max(page/2, 1)*max(count, 1), 0, max(n-1, 0)), true
+    case key.Matches(msg, Keys.HalfPageUp):
+        return clamp(cur-max(page/2, 1)*max(count, 1), 0, max(n-1, 0)), true
+    }
+    return cur, false
+}
+
+// Pending returns the count typed so far, 0 when none
+func (v VimNav) Pending() int {
+    return v.count
+}
+
+// Reset drops a motion in progress
+func (v *VimNav) Reset() {
+    v.count, v.g = 0, false
+}
+
+// NavViewport applies a vim-style motion to a viewport, scrolling by its top
+// line, and reports whether the key was used
+func NavViewport(vp *viewport.Model, nav *VimNav, msg tea.KeyMsg) bool {
+    to, ok := nav.Move(msg, vp.YOffset, vp.TotalLineCount(), vp.Height)
+    if ok {
+        vp.SetYOffset(to)
+    }
+    return ok
+}
@@ -0,0 +1,197 @@
+package organisms
+
+import (
+    "fmt"
+    "strings"
+
+    "github.com/charmbracelet/bubbles/table"
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+    "gnostic-tui/ui/theme"
+    "gnostic-tui/ui/zone"
+)
+
+// RowSource hands out rows by index, so a table can show a dataset it never
+// holds in full. At is only called for rows on screen.
+type RowSource interface {
+    Len() int
+    At(i int) Row
+}
+
+// RowSlice serves rows from memory
+type RowSlice []Row
+
+func (s RowSlice) Len() int     { return len(s) }
+func (s RowSlice) At(i int) Row { return s[i] }
+
+// VirtualTable shows a window onto a RowSource. Moving and drawing cost the
+// same for a hundred rows as for millions.
+type VirtualTable struct {
+    columns []Column
+    source  RowSource
+    cursor  int
+    offset  int
+    height  int // Lines including header and footer
+    focused bool
+    styles  table.Styles
+    id      string
+}
+
+func NewVirtualTable(columns []Column, source RowSource, height int) VirtualTable {
+    t := VirtualTable{
+        columns: columns,
+        source:  source,
+        styles:  tableStyles(),
+        id:      zone.NewID("vtable"),
+    }
+    t.SetHeight(height)
+    return t
+}
+
+func (t *VirtualTable) Focus() {
+    t.focused = true
+}
+
+func (t *VirtualTable) Blur() {
+    t.focused = false
+}
+
+func (t VirtualTable) Focused() bool {
+    return t.focused
+}
+
+// SetHeight sets the height in lines, header and position footer included
+func (t *VirtualTable) SetHeight(h int) {
+    t.height = max(h, 4)
+    t.SetCursor(t.cursor)
+}
+
+// SetSource swaps the data, e.g. after the source grew, keeping the cursor in range
+func (t *VirtualTable) SetSource(source RowSource) {
+    t.source = source
+    t.SetCursor(t.cursor)
+}
+
+func (t VirtualTable) Source() RowSource {
+    return t.source
+}
+
+func (t VirtualTable) Cursor() int {
+    return t.cursor
+}
+
+// SetCursor moves to row n, scrolling only as far as needed to show it
+func (t *VirtualTable) SetCursor(n int) {
+    total, h := t.source.Len(), t.bodyHeight()
+    t.cursor = clamp(n, 0, total-1)
+    if t.cursor < t.offset {
+        t.offset = t.cursor
+    }
+    if t.cursor >= t.offset+h {
+        t.offset = t.cursor - h + 1
+    }
+    t.offset = clamp(t.offset, 0, max(total-h, 0))
+}
+
+// SelectedRow returns the row under the cursor, or nil for an empty source
+func (t VirtualTable) SelectedRow() Row {
+    if t.source.Len() == 0 {
+        return nil
+    }
+    return t.source.At(t.cursor)
+}
+
+func (t VirtualTable) bodyHeight() int {
+    return t.height - 3
+}
+
+func (t VirtualTable) Update(msg tea.Msg) (VirtualTable, tea.Cmd) {
+    switch msg := msg.(type) {
+    case tea.KeyMsg:
+        if !t.focused {
+            return t, nil
+        }
+        switch msg.String() {
+        case "up", "k":
+            t.SetCursor(t.cursor - 1)
+        case "down", "j":
+            t.SetCursor(t.cursor + 1)
+        case "pgup":
+            t.SetCursor(t.cursor - t.bodyHeight())
+        case "pgdown":
+            t.SetCursor(t.cursor + t.bodyHeight())
+        case "home":
+            t.SetCursor(0)
+        case "end":
+            t.SetCursor(t.source.Len() - 1)
+        }
+    case tea.MouseMsg:
+        if msg.Action != tea.MouseActionPress {
+            return t, nil
+        }
+        switch body := zone.Get(t.id + ":body"); msg.Button {
+        case tea.MouseButtonWheelUp:
+            if body.InBounds(msg) {
+                t.SetCursor(t.cursor - 3)
+            }
+        case tea.MouseButtonWheelDown:
+            if body.InBounds(msg) {
+                t.SetCursor(t.cursor + 3)
+            }
+        case tea.MouseButtonLeft:
+            if body.InBounds(msg) {
+                _, y := body.Pos(msg)
+                if n := t.offset + y; n < t.source.Len() {
+                    t.SetCursor(n)
+                }
+            }
+        }
+    }
+    return t, nil
+}
+
+func (t VirtualTable) View() string {
+    header := make([]string, len(t.columns))
+    for i, c := range t.columns {
+        header[i] = t.styles.Header.Render(fitCell(c.Title, c.Width))
+    }
+    head := lipgloss.JoinHorizontal(lipgloss.Top, header...)
+
+    blank := strings.Repeat(" ", lipgloss.Width(head))
+    total := t.source.Len()
+    body := make([]string, t.bodyHeight())
+    for y := range body {
+        i := t.offset + y
+        if i >= total {
+            body[y] = blank
+            continue
+        }
+
+        style := t.styles.Cell
+        if i == t.cursor {
+            style = selectedStyle(t.styles)
+        }
+        row := t.source.At(i)
+        var b strings.Builder
+        for col, c := range t.columns {
+            cell := ""
+            if col < len(row) {
+                cell = row[col]
+            }
+            b.WriteString(style.Render(fitCell(cell, c.Width)))
+        }
+        body[y] = b.String()
+    }
+
+    pos := "empty"
+    if total > 0 {
+        pos = fmt.Sprintf("%d of %d", t.cursor+1, total)
+    }
+    footer := lipgloss.PlaceHorizontal(lipgloss.Width(head), lipgloss.Right, theme.Caption.Render(pos))
+
+    return lipgloss.JoinVertical(lipgloss.Left,
+        head,
+        zone.Mark(t.id+":body", strings.Join(body, "\n")),
+        footer,
+    )
+}
@@ -0,0 +1,105 @@
+// Package state persists small pieces of UI state, such as a table's column
+// layout, between runs.
+//
+// Components take a Store and a key; values are stored as JSON so any
+// backend that can keep bytes under a name will do.
+package state
+
+import (
+    "encoding/json"
+    "errors"
+    "os"
+    "path/filepath"
+    "sync"
+)
+
+// ErrNotFound is returned by Load when nothing is saved under the key
+var ErrNotFound = errors.New("state: not found")
+
+// Store saves and restores values by key
+type Store interface {
+    Load(key string, v any) error
+    Save(key string, v any) error
+}
+
+// FileStore keeps every key in one JSON file
+type FileStore struct {
+    path string
+    mu   sync.Mutex
+}
+
+// NewFileStore stores state in the file at path, creating it on first save
+func NewFileStore(path string) *FileStore {
+    return &FileStore{path: path}
+}
+
+// DefaultFileStore stores state under the user's config directory, e.g.
+// ~/.config/<app>/state.json
+func DefaultFileStore(app string) (*FileStore, error) {
+    dir, err := os.UserConfigDir()
+    if err != nil {
+        return nil, err
+    }
+    return NewFileStore(filepath.Join(dir, app, "state.json")), nil
+}
+
+func (s *FileStore) Load(key string, v any) error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    all, err := s.read()
+    if err != nil {
+        return err
+    }
+    raw, ok := all[key]
+    if !ok {
+        return ErrNotFound
+    }
+    return json.Unmarshal(raw, v)
+}
+
+func (s *FileStore) Save(key string, v any) error {
+    raw, err := json.Marshal(v)
+    if err != nil {
+        return err
+    }
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    all, err := s.read()
+    if err != nil {
+        return err
+    }
+    all[key] = raw
+
+    data, err := json.MarshalIndent(all, "", "  ")
+    if err != nil {
+        return err
+    }
+    if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+        return err
+    }
+    // Write through a temporary file so a crash never leaves half a file behind
+    tmp := s.path + ".tmp"
+    if err := os.WriteFile(tmp, data, 0o644); err != nil {
+        return err
+    }
+    return os.Rename(tmp, s.path)
+}
+
+// read loads the whole file; a missing file is an empty store
+func (s *FileStore) read() (map[string]json.RawMessage, error) {
+    all := map[string]json.RawMessage{}
+    data, err := os.ReadFile(s.path)
+    if errors.Is(err, os.ErrNotExist) {
+        return all, nil
+    }
+    if err != nil {
+        return nil, err
+    }
+    if err := json.Unmarshal(data, &all); err != nil {
+        return nil, err
+    }
+    return all, nil
+}
@@ -0,0 +1,20 @@
+package theme
+
+import "github.com/charmbracelet/lipgloss"
+
+// Inherit layers child over parent like a CSS cascade: anything child leaves
+// unset, including padding, falls back to the parent value.
+func Inherit(parent, child lipgloss.Style) lipgloss.Style {
+    s := child.Copy().Inherit(parent)
+
+    // lipgloss never inherits padding, so carry it over when the child has none of its own
+    if top, right, bottom, left := child.GetPadding(); top+right+bottom+left == 0 {
+        s = s.Padding(parent.GetPadding())
+    }
+    return s
+}
+
+// Derive cascades s from BaseStyle, the root context every component renders in
+func Derive(s lipgloss.Style) lipgloss.Style {
+    return Inherit(BaseStyle, s)
+}
@@ -0,0 +1,49 @@
+package theme
+
+import (
+    "fmt"
+    "strconv"
+
+    "github.com/charmbracelet/lipgloss"
+)
+
+// Blend mixes two hex colors, t=0 giving a and t=1 giving b. Colors that are
+// not hex (ANSI palette indices) cannot be mixed and snap to the nearer end.
+func Blend(a, b lipgloss.Color, t float64) lipgloss.Color {
+    if t <= 0 {
+        return a
+    }
+    if t >= 1 {
+        return b
+    }
+
+    ar, ag, ab, okA := parseHex(a)
+    br, bg, bb, okB := parseHex(b)
+    if !okA || !okB {
+        if t < 0.5 {
+            return a
+        }
+        return b
+    }
+
+    mix := func(x, y int) int {
+        return x + int(float64(y-x)*t+0.5)
+    }
+    return lipgloss.Color(fmt.Sprintf("#%02x%02x%02x", mix(ar, br), mix(ag, bg), mix(ab, bb)))
+}
+
+// parseHex reads "#rrggbb" and the short "#rgb" form
+func parseHex(c lipgloss.Color) (r, g, b int, ok bool) {
+    s := string(c)
+    if len(s) == 4 && s[0] == '#' {
+        s = "#" + string([]byte{s[1], s[1], s[2], s[2], s[3], s[3]})
+    }
+    if len(s) != 7 || s[0] != '#' {
+        return 0, 0, 0, false
+    }
+    v, err := strconv.ParseUint(s[1:], 16, 32)
+    if err != nil {
+        return 0, 0, 0, false
+    }
+    return int(v >> 16 & 0xff), int(v >> 8 & 0xff), int(v & 0xff), true
+}
@@ -0,0 +1,22 @@
+package theme
+
+import "os"
+
+// EnvVar names the environment variable that selects a registered theme at startup
+const EnvVar = "GNOSTIC_THEME"
+
+func init() {
+    // Built-in themes are available before main runs; an unknown name keeps the default.
+    _ = FromEnv()
+}
+
+// FromEnv applies the theme named by GNOSTIC_THEME. It is a no-op when the
+// variable is unset, so apps that register custom themes can call it again
+// after registration.
+func FromEnv() error {
+    name := os.Getenv(EnvVar)
+    if name == "" {
+        return nil
+    }
+    return Use(name)
+}
@@ -0,0 +1,95 @@
+package theme
+
+import (
+    "fmt"
+    "sort"
+    "sync"
+
+    "github.com/charmbracelet/lipgloss"
+)
+
+// The built-in palettes
+var (
+    Cosmos = Current()
+
+    Dawn = Palette{
+        Primary:   lipgloss.Color("#4f46e5"), // Indigo 600
+        Secondary: lipgloss.Color("#db2777"), // Pink 600
+        Accent:    lipgloss.Color("#059669"), // Emerald 600
+        Warning:   lipgloss.Color("#d97706"), // Amber 600
+        Danger:    lipgloss.Color("#dc2626"), // Red 600
+        Text:      lipgloss.Color("#0f172a"), // Slate 900
+        Subtext:   lipgloss.Color("#64748b"), // Slate 500
+        Surface:   lipgloss.Color("#e2e8f0"), // Slate 200
+        Border:    lipgloss.Color("#cbd5e1"), // Slate 300
+    }
+
+    Mono = Palette{
+        Primary:   lipgloss.Color("#e5e5e5"),
+        Secondary: lipgloss.Color("#a3a3a3"),
+        Accent:    lipgloss.Color("#d4d4d4"),
+        Warning:   lipgloss.Color("#a3a3a3"),
+        Danger:    lipgloss.Color("#ffffff"),
+        Text:      lipgloss.Color("#fafafa"),
+        Subtext:   lipgloss.Color("#737373"),
+        Surface:   lipgloss.Color("#262626"),
+        Border:    lipgloss.Color("#404040"),
+    }
+)
+
+var (
+    registryMu sync.RWMutex
+    registry   = map[string]Palette{
+        "cosmos": Cosmos,
+        "dawn":   Dawn,
+        "mono":   Mono,
+    }
+    active = "cosmos"
+)
+
+// Register adds or replaces a named theme
+func Register(name string, p Palette) {
+    registryMu.Lock()
+    defer registryMu.Unlock()
+    registry[name] = p
+}
+
+// Lookup returns the theme registered under name
+func Lookup(name string) (Palette, bool) {
+    registryMu.RLock()
+    defer registryMu.RUnlock()
+    p, ok := registry[name]
+    return p, ok
+}
+
+// Names lists the registered themes in alphabetical order
+func Names() []string {
+    registryMu.RLock()
+    defer registryMu.RUnlock()
+    names := make([]string, 0, len(registry))
+    for name := range registry {
+        names = append(names, name)
+    }
+    sort.Strings(names)
+    return names
+}
+
+// Use applies the theme registered under name
+func Use(name string) error {
+    p, ok := Lookup(name)
+    if !ok {
+        return fmt.Errorf("theme: unknown theme %q", name)
+    }
+    Apply(p)
+    registryMu.Lock()
+    active = name
+    registryMu.Unlock()
+    return nil
+}
+
+// Active returns the name of the theme last selected with Use
+func Active() string {
+    registryMu.RLock()
+    defer registryMu.RUnlock()
+    return active
+}
@@ -0,0 +1,43 @@
+package theme
+
+// Density selects how much whitespace the spacing scale hands out
+type Density int
+
+const (
+    Comfortable Density = iota
+    Compact
+)
+
+// Cells per step of the spacing scale, indexed by Space(1..8)
+var spacingScales = map[Density][8]int{
+    Comfortable: {1, 2, 3, 4, 6, 8, 12, 16},
+    Compact:     {0, 1, 2, 2, 3, 4, 6, 8},
+}
+
+var density = Comfortable
+
+// Space returns the number of cells for step n (1..8) of the spacing scale.
+// Steps outside the scale are clamped; zero and below mean no space.
+func Space(n int) int {
+    if n <= 0 {
+        return 0
+    }
+    if n > 8 {
+        n = 8
+    }
+    return spacingScales[density][n-1]
+}
+
+// SetDensity switches the spacing scale and rebuilds the shared styles
+func SetDensity(d Density) {
+    if _, ok := spacingScales[d]; !ok {
+        return
+    }
+    density = d
+    buildStyles()
+}
+
+// CurrentDensity returns the active spacing density
+func CurrentDensity() Density {
+    return density
+}
@@ -2,6 +2,40 @@ package theme
 
 import "github.com/charmbracelet/lipgloss"
 
+// Palette is a complete set of color tokens that can be registered and applied as a theme
+type Palette struct {
+    Primary   lipgloss.Color
+    Secondary lipgloss.Color
+    Accent    lipgloss.Color
+    Warning   lipgloss.Color
+    Danger    lipgloss.Color
+    Text      lipgloss.Color
+    Subtext   lipgloss.Color
+    Surface   lipgloss.Color
+    Border    lipgloss.Color
+}
+
+// Token is a single named color of a palette
+type Token struct {
+    Name  string
+    Color lipgloss.Color
+}
+
+// Tokens lists every color of the palette in display order
+func (p Palette) Tokens() []Token {
+    return []Token{
+        {"Primary", p.Primary},
+        {"Secondary", p.Secondary},
+        {"Accent", p.Accent},
+        {"Warning", p.Warning},
+        {"Danger", p.Danger},
+        {"Text", p.Text},
+        {"Subtext", p.Subtext},
+        {"Surface", p.Surface},
+        {"Border", p.Border},
+    }
+}
+
 // The Palette of the Cosmos
 var (
     Primary   = lipgloss.Color("#6366f1") // Indigo
@@ -17,21 +51,79 @@ var (
 
 // The Styles of Form
 var (
+    BaseStyle    lipgloss.Style
+    CardStyle    lipgloss.Style
+    TitleStyle   lipgloss.Style
+    FocusedStyle lipgloss.Style
+)
+
+// The Typography of the Word
+var (
+    H1      lipgloss.Style // Screen titles
+    H2      lipgloss.Style // Section and card titles
+    Label   lipgloss.Style // Field labels and column headers
+    Caption lipgloss.Style // Hints and secondary copy
+)
+
+func init() {
+    buildStyles()
+}
+
+// buildStyles derives the shared styles from the current palette
+func buildStyles() {
+    H1 = lipgloss.NewStyle().
+        Foreground(Primary).
+        Bold(true).
+        Underline(true)
+
+    H2 = lipgloss.NewStyle().
+        Foreground(Primary).
+        Bold(true)
+
+    Label = lipgloss.NewStyle().
+        Foreground(Subtext).
+        Bold(true)
+
+    Caption = lipgloss.NewStyle().
+        Foreground(Subtext).
+        Faint(true)
+
     BaseStyle = lipgloss.NewStyle().
         Foreground(Text)
 
     CardStyle = lipgloss.NewStyle().
         Border(lipgloss.RoundedBorder()).
         BorderForeground(Border).
-        Padding(1, 2).
-        MarginBottom(1)
+        Padding(Space(1), Space(2)).
+        MarginBottom(Space(1))
 
-    TitleStyle = lipgloss.NewStyle().
-        Foreground(Primary).
-        Bold(true).
-        MarginBottom(1)
+    TitleStyle = H1.Copy().
+        MarginBottom(Space(1))
 
     FocusedStyle = lipgloss.NewStyle().
         Border(lipgloss.RoundedBorder()).
         BorderForeground(Primary)
-)
\ No newline at end of file
+}
+
+// Apply makes p the active palette and rebuilds the shared styles
+func Apply(p Palette) {
+    Primary, Secondary, Accent = p.Primary, p.Secondary, p.Accent
+    Warning, Danger = p.Warning, p.Danger
+    Text, Subtext, Surface, Border = p.Text, p.Subtext, p.Surface, p.Border
+    buildStyles()
+}
+
+// Current returns the active palette
+func Current() Palette {
+    return Palette{
+        Primary:   Primary,
+        Secondary: Secondary,
+        Accent:    Accent,
+        Warning:   Warning,
+        Danger:    Danger,
+        Text:      Text,
+        Subtext:   Subtext,
+        Surface:   Surface,
+        Border:    Border,
+    }
+}
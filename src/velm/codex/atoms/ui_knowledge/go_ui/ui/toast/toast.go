@@ -0,0 +1,39 @@
+// Package toast carries short-lived notifications to a molecules.Toaster.
+//
+// Any component can return toast.Show("Saved") (or Success, Warn, Error)
+// as a command; the Toaster mounted by the top-level model picks the message
+// up, stacks it in a corner and lets it expire.
+package toast
+
+import (
+    "time"
+
+    tea "github.com/charmbracelet/bubbletea"
+)
+
+// Kind picks a toast's color and icon
+type Kind int
+
+const (
+    KindInfo Kind = iota
+    KindSuccess
+    KindWarning
+    KindError
+)
+
+// Msg asks the Toaster to show a toast. A zero Duration uses the Toaster's default.
+type Msg struct {
+    Text     string
+    Kind     Kind
+    Duration time.Duration
+}
+
+// Send shows a fully specified toast
+func Send(m Msg) tea.Cmd {
+    return func() tea.Msg { return m }
+}
+
+func Show(text string) tea.Cmd    { return Send(Msg{Text: text, Kind: KindInfo}) }
+func Success(text string) tea.Cmd { return Send(Msg{Text: text, Kind: KindSuccess}) }
+func Warn(text string) tea.Cmd    { return Send(Msg{Text: text, Kind: KindWarning}) }
+func Error(text string) tea.Cmd   { return Send(Msg{Text: text, Kind: KindError}) }
@@ -0,0 +1,206 @@
+// Package zone tracks where marked components land on screen so mouse events
+// can be hit-tested against them.
+//
+// Components wrap their rendered output with Mark; the top-level View passes
+// the finished frame through Scan, which strips the markers and records the
+// bounds of every zone. Update then asks Get(id).InBounds(mouseMsg).
+package zone
+
+import (
+    "strconv"
+    "strings"
+    "sync"
+    "sync/atomic"
+    "unicode/utf8"
+
+    tea "github.com/charmbracelet/bubbletea"
+    "github.com/charmbracelet/lipgloss"
+)
+
+// Info is the on-screen rectangle a zone occupied in the last scanned frame
+type Info struct {
+    StartX, StartY int
+    EndX, EndY     int // Inclusive
+    found          bool
+}
+
+// IsZero reports whether the zone was absent from the last frame
+func (z Info) IsZero() bool {
+    return !z.found
+}
+
+// InBounds reports whether the mouse event falls inside the zone
+func (z Info) InBounds(msg tea.MouseMsg) bool {
+    if !z.found {
+        return false
+    }
+    return msg.X >= z.StartX && msg.X <= z.EndX && msg.Y >= z.StartY && msg.Y <= z.EndY
+}
+
+// Pos returns the mouse position relative to the zone's top-left corner
+func (z Info) Pos(msg tea.MouseMsg) (x, y int) {
+    return msg.X - z.StartX, msg.Y - z.StartY
+}
+
+// Clicked reports whether msg is a left-button press inside the zone
+func (z Info) Clicked(msg tea.MouseMsg) bool {
+    return msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft && z.InBounds(msg)
+}
+
+// Manager assigns markers to zone ids and remembers their bounds
+type Manager struct {
+    mu      sync.RWMutex
+    ids     map[string]int
+    names   map[int]string
+    zones   map[string]Info
+    counter int
+}
+
+func New() *Manager {
+    return &Manager{
+        ids:   map[string]int{},
+        names: map[int]string{},
+        zones: map[string]Info{},
+    }
+}
+
+var idCounter atomic.Int64
+
+// NewID returns a zone id unique to this process, e.g. "select:3", for
+// components that need one per instance
+func NewID(prefix string) string {
+    return prefix + ":" + strconv.FormatInt(idCounter.Add(1), 10)
+}
+
+// Default is the manager used by the package-level helpers
+var Default = New()
+
+func Mark(id string, s string) string { return Default.Mark(id, s) }
+func Scan(view string) string         { return Default.Scan(view) }
+func Get(id string) Info              { return Default.Get(id) }
+
+// Markers look like CSI sequences ("ESC[12z" ... "ESC[12Z"), which lipgloss
+// measures as zero width, so marked content lays out exactly as unmarked.
+const (
+    startMarker = 'z'
+    endMarker   = 'Z'
+)
+
+// Mark wraps rendered content so Scan can locate it
+func (m *Manager) Mark(id string, s string) string {
+    m.mu.Lock()
+    n, ok := m.ids[id]
+    if !ok {
+        m.counter++
+        n = m.counter
+        m.ids[id] = n
+        m.names[n] = id
+    }
+    m.mu.Unlock()
+
+    num := strconv.Itoa(n)
+    return "\x1b[" + num + string(startMarker) + s + "\x1b[" + num + string(endMarker)
+}
+
+// Get returns the bounds of a zone from the last scanned frame
+func (m *Manager) Get(id string) Info {
+    m.mu.RLock()
+    defer m.mu.RUnlock()
+    return m.zones[id]
+}
+
+// Scan records the position of every marked zone in a finished frame and
+// returns the frame with the markers removed. Zones missing from the frame
+// are forgotten.
+func (m *Manager) Scan(view string) string {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+
+    zones := map[string]Info{}
+    var out strings.Builder
+    out.Grow(len(view))
+
+    x, y := 0, 0
+    for i := 0; i < len(view); {
+        c := view[i]
+        switch {
+        case c == '\n':
+            out.WriteByte(c)
+            x = 0
+            y++
+            i++
+        case c == '\x1b':
+            seq, n, kind := m.readEscape(view[i:])
+            switch kind {
+            case startMarker:
+                if id, ok := m.names[n]; ok {
+                    zones[id] = Info{StartX: x, StartY: y, found: true}
+                }
+            case endMarker:
+                if id, ok := m.names[n]; ok {
+                    if z, ok := zones[id]; ok {
+                        z.EndX, z.EndY = x-1, y
+                        zones[id] = z
+                    }
+                }
+            default:
+                out.WriteString(seq)
+            }
+            i += len(seq)
+        default:
+            r, size := utf8.DecodeRuneInString(view[i:])
+            out.WriteString(view[i : i+size])
+            x += lipgloss.Width(string(r))
+            i += size
+        }
+    }
+
+    m.zones = zones
+    return out.String()
+}
+
+// readEscape splits off the escape sequence at the start of s. For zone
+// markers it also returns the marker number and kind, otherwise kind is 0.
+func (m *Manager) readEscape(s string) (seq string, n int, kind rune) {
+    if len(s) >= 2 && s[1] == ']' {
+        // OSC (e.g. hyperlinks) runs until BEL or ST, letters included
+        for i := 2; i < len(s); i++ {
+            if s[i] == '\a' {
+                return s[:i+1], 0, 0
+            }
+            if s[i] == '\x1b' && i+1 < len(s) && s[i+1] == '\\' {
+                return s[:i+2], 0, 0
+            }
+        }
+        return s, 0, 0
+    }
+    if len(s) < 2 || s[1] != '[' {
+        // Not a CSI sequence; copy it through up to its terminator
+        for i := 1; i < len(s); i++ {
+            if isTerminator(s[i]) {
+                return s[:i+1], 0, 0
+            }
+        }
+        return s, 0, 0
+    }
+
+    i := 2
+    for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+        i++
+    }
+    if i < len(s) && i > 2 && (s[i] == byte(startMarker) || s[i] == byte(endMarker)) {
+        n, _ = strconv.Atoi(s[2:i])
+        return s[:i+1], n, rune(s[i])
+    }
+    for ; i < len(s); i++ {
+        if isTerminator(s[i]) {
+            return s[:i+1], 0, 0
+        }
+    }
+    return s, 0, 0
+}
+
+// isTerminator matches the rule lipgloss uses to find the end of an escape sequence
+func isTerminator(c byte) bool {
+    return (c >= 0x40 && c <= 0x5a) || (c >= 0x61 && c <= 0x7a)
+}